@@ -0,0 +1,241 @@
+package classfile
+
+import (
+	"archive/zip"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"strings"
+)
+
+const (
+	// Magic is the magic number that every Java class file starts with.
+	Magic uint32 = 0xCAFEBABE
+
+	// MinMajorVersion is the oldest class file major version (JDK 1.1).
+	// There is no upper bound, since the server decides which versions it can load.
+	MinMajorVersion uint16 = 45
+)
+
+// constant pool tags defined in the Java Virtual Machine Specification, Chapter 4.4.
+const (
+	constantUtf8               byte = 1
+	constantInteger            byte = 3
+	constantFloat              byte = 4
+	constantLong               byte = 5
+	constantDouble             byte = 6
+	constantClass              byte = 7
+	constantString             byte = 8
+	constantFieldref           byte = 9
+	constantMethodref          byte = 10
+	constantInterfaceMethodref byte = 11
+	constantNameAndType        byte = 12
+	constantMethodHandle       byte = 15
+	constantMethodType         byte = 16
+	constantDynamic            byte = 17
+	constantInvokeDynamic      byte = 18
+	constantModule             byte = 19
+	constantPackage            byte = 20
+)
+
+// ClassFile defines a Java class file with the information needed to register it as a contract or a function.
+type ClassFile struct {
+	BinaryName   string
+	MajorVersion uint16
+	MinorVersion uint16
+	Bytes        []byte
+}
+
+// Parse validates the given bytes as a Java class file and derives its fully qualified binary name,
+// e.g. "com.org1.contract.StateUpdater", from the constant pool.
+func Parse(b []byte) (c ClassFile, err error) {
+	var r = reader{bytes: b}
+
+	if r.u4() != Magic || r.err != nil {
+		return c, errors.New("not a Java class file")
+	}
+
+	c.MinorVersion = r.u2()
+	c.MajorVersion = r.u2()
+
+	if r.err != nil {
+		return c, r.err
+	}
+
+	if c.MajorVersion < MinMajorVersion {
+		return c, fmt.Errorf("unsupported class file version %d.%d", c.MajorVersion, c.MinorVersion)
+	}
+
+	var (
+		count      = int(r.u2())
+		utf8s      = make(map[int]string)
+		classNames = make(map[int]int)
+	)
+
+	for i := 1; i < count && r.err == nil; i++ {
+		switch tag := r.u1(); tag {
+		case constantUtf8:
+			utf8s[i] = string(r.next(int(r.u2())))
+		case constantClass:
+			classNames[i] = int(r.u2())
+		case constantString, constantMethodType, constantModule, constantPackage:
+			r.next(2)
+		case constantMethodHandle:
+			r.next(3)
+		case constantInteger, constantFloat, constantFieldref, constantMethodref,
+			constantInterfaceMethodref, constantNameAndType, constantDynamic, constantInvokeDynamic:
+			r.next(4)
+		case constantLong, constantDouble:
+			// 8-byte constants take up two entries in the constant pool.
+			r.next(8)
+			i++
+		default:
+			if r.err == nil {
+				r.err = fmt.Errorf("unknown constant pool tag %d at index %d", tag, i)
+			}
+		}
+	}
+
+	// skip access_flags
+	r.next(2)
+	var thisClass = int(r.u2())
+
+	if r.err != nil {
+		return c, r.err
+	}
+
+	var (
+		nameIndex, isClass = classNames[thisClass]
+		name, isUtf8       = utf8s[nameIndex]
+	)
+
+	if !isClass || !isUtf8 || name == "" {
+		return c, errors.New("invalid this_class in the class file")
+	}
+
+	c.BinaryName = strings.ReplaceAll(name, "/", ".")
+	c.Bytes = b
+
+	return
+}
+
+// ReadFile reads and parses the Java class file at the given path.
+func ReadFile(path string) (c ClassFile, err error) {
+	var b []byte
+
+	if b, err = ioutil.ReadFile(path); err != nil {
+		return
+	}
+
+	return Parse(b)
+}
+
+// ReadFromJar reads and parses the class of the given binary name, e.g. "com.org1.contract.StateUpdater",
+// from the jar file at the given path.
+func ReadFromJar(path string, binaryName string) (c ClassFile, err error) {
+	var jar *zip.ReadCloser
+
+	if jar, err = zip.OpenReader(path); err != nil {
+		return
+	}
+	defer jar.Close()
+
+	var entry = strings.ReplaceAll(binaryName, ".", "/") + ".class"
+
+	for _, f := range jar.File {
+		if f.Name != entry {
+			continue
+		}
+
+		rc, err := f.Open()
+		if err != nil {
+			return c, err
+		}
+		defer rc.Close()
+
+		b, err := ioutil.ReadAll(rc)
+		if err != nil {
+			return c, err
+		}
+
+		if c, err = Parse(b); err != nil {
+			return c, err
+		}
+
+		if c.BinaryName != binaryName {
+			return c, fmt.Errorf("%s in %s defines %s", entry, path, c.BinaryName)
+		}
+
+		return c, nil
+	}
+
+	return c, fmt.Errorf("%s is not found in %s", entry, path)
+}
+
+// ListJar lists the binary names of all the classes in the jar file at the given path.
+func ListJar(path string) (binaryNames []string, err error) {
+	var jar *zip.ReadCloser
+
+	if jar, err = zip.OpenReader(path); err != nil {
+		return
+	}
+	defer jar.Close()
+
+	for _, f := range jar.File {
+		if strings.HasSuffix(f.Name, ".class") &&
+			f.Name != "module-info.class" &&
+			!strings.HasSuffix(f.Name, "/package-info.class") {
+			binaryNames = append(binaryNames, strings.ReplaceAll(strings.TrimSuffix(f.Name, ".class"), "/", "."))
+		}
+	}
+
+	return
+}
+
+// reader reads big-endian values sequentially and keeps the first error of out-of-range reads.
+type reader struct {
+	bytes  []byte
+	offset int
+	err    error
+}
+
+func (r *reader) next(n int) []byte {
+	if r.err != nil {
+		return nil
+	}
+
+	if n < 0 || r.offset+n > len(r.bytes) {
+		r.err = errors.New("unexpected end of the class file")
+		return nil
+	}
+
+	var b = r.bytes[r.offset : r.offset+n]
+	r.offset += n
+
+	return b
+}
+
+func (r *reader) u1() byte {
+	if b := r.next(1); b != nil {
+		return b[0]
+	}
+
+	return 0
+}
+
+func (r *reader) u2() uint16 {
+	if b := r.next(2); b != nil {
+		return binary.BigEndian.Uint16(b)
+	}
+
+	return 0
+}
+
+func (r *reader) u4() uint32 {
+	if b := r.next(4); b != nil {
+		return binary.BigEndian.Uint32(b)
+	}
+
+	return 0
+}
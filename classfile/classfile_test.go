@@ -0,0 +1,137 @@
+package classfile
+
+import (
+	"archive/zip"
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	var (
+		c   ClassFile
+		b   []byte
+		err error
+	)
+
+	if b, err = ioutil.ReadFile("../example/StateUpdater.class"); err != nil {
+		t.Fatalf("should be able to read the test data")
+	}
+
+	if c, err = Parse(b); err != nil {
+		t.Errorf("should be able to parse a class file: %v", err)
+	}
+
+	if c.BinaryName != "com.org1.contract.StateUpdater" {
+		t.Errorf("BinaryName is not match: %s", c.BinaryName)
+	}
+
+	if c.MajorVersion != 52 || c.MinorVersion != 0 {
+		t.Errorf("version is not match: %d.%d", c.MajorVersion, c.MinorVersion)
+	}
+
+	if !bytes.Equal(c.Bytes, b) {
+		t.Errorf("Bytes should be the class file")
+	}
+
+	if _, err = Parse([]byte("not a class")); err == nil {
+		t.Errorf("should not parse bytes without the magic number")
+	}
+
+	if _, err = Parse(b[:100]); err == nil {
+		t.Errorf("should not parse a truncated class file")
+	}
+
+	var newer = append([]byte{}, b...)
+	newer[7] = 99
+
+	if c, err := Parse(newer); err != nil || c.MajorVersion != 99 || c.BinaryName == "" {
+		t.Errorf("should leave the newer versions to the server: %v %v", c, err)
+	}
+
+	var older = append([]byte{}, b...)
+	older[7] = 44
+
+	if _, err = Parse(older); err == nil {
+		t.Errorf("should not parse a class file older than JDK 1.1")
+	}
+}
+
+func TestReadFile(t *testing.T) {
+	var classes = map[string]string{
+		"../example/StateReader.class":                     "com.org1.contract.StateReader",
+		"../e2e/smallbank_benchmark/Amalgamate.class":      "com.example.contract.smallbank.Amalgamate",
+		"../e2e/smallbank_benchmark/CreateAccount.class":   "com.example.contract.smallbank.CreateAccount",
+		"../e2e/smallbank_benchmark/DepositChecking.class": "com.example.contract.smallbank.DepositChecking",
+		"../e2e/smallbank_benchmark/SendPayment.class":     "com.example.contract.smallbank.SendPayment",
+		"../e2e/smallbank_benchmark/TransactSavings.class": "com.example.contract.smallbank.TransactSavings",
+		"../e2e/smallbank_benchmark/WriteCheck.class":      "com.example.contract.smallbank.WriteCheck",
+	}
+
+	for path, name := range classes {
+		c, err := ReadFile(path)
+
+		if err != nil {
+			t.Errorf("should be able to read %s: %v", path, err)
+		}
+
+		if c.BinaryName != name {
+			t.Errorf("BinaryName of %s is not match: %s", path, c.BinaryName)
+		}
+	}
+
+	if _, err := ReadFile("not-found.class"); err == nil {
+		t.Errorf("should get an error for a missing file")
+	}
+}
+
+func TestReadFromJar(t *testing.T) {
+	var (
+		path = filepath.Join(t.TempDir(), "contracts.jar")
+		jar  bytes.Buffer
+		w    = zip.NewWriter(&jar)
+	)
+
+	for entry, file := range map[string]string{
+		"com/org1/contract/StateUpdater.class": "../example/StateUpdater.class",
+		"com/org1/contract/StateReader.class":  "../example/StateReader.class",
+		"com/org1/contract/Misplaced.class":    "../example/StateReader.class",
+	} {
+		b, _ := ioutil.ReadFile(file)
+		f, _ := w.Create(entry)
+		f.Write(b)
+	}
+
+	w.Create("META-INF/MANIFEST.MF")
+	w.Close()
+
+	if err := os.WriteFile(path, jar.Bytes(), 0600); err != nil {
+		t.Fatalf("should be able to write the jar file")
+	}
+
+	c, err := ReadFromJar(path, "com.org1.contract.StateUpdater")
+
+	if err != nil {
+		t.Errorf("should be able to read a class from the jar file: %v", err)
+	}
+
+	if c.BinaryName != "com.org1.contract.StateUpdater" {
+		t.Errorf("BinaryName is not match: %s", c.BinaryName)
+	}
+
+	if _, err = ReadFromJar(path, "com.org1.contract.NotFound"); err == nil {
+		t.Errorf("should get an error for a class not in the jar file")
+	}
+
+	if _, err = ReadFromJar(path, "com.org1.contract.Misplaced"); err == nil {
+		t.Errorf("should get an error for a class defining another binary name")
+	}
+
+	names, err := ListJar(path)
+
+	if err != nil || len(names) != 3 {
+		t.Errorf("should list all the classes in the jar file: %v", names)
+	}
+}
@@ -2,7 +2,11 @@ package config
 
 import (
 	"bytes"
+	"fmt"
 	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"time"
 
 	"github.com/go-playground/validator/v10"
 	"github.com/spf13/viper"
@@ -33,19 +37,49 @@ const (
 	auditorTLSCaRootCertPem                 string = "scalar.dl.client.auditor.tls.ca_root_cert_pem"
 	auditorLinearizableValidationEnabled    string = "scalar.dl.client.auditor.linearizable_validation.enabled"
 	auditorLinearizableValidationContractID string = "scalar.dl.client.auditor.linearizable_validation.contract_id"
+	authenticationMethod                    string = "scalar.dl.client.authentication.method"
+	entityID                                string = "scalar.dl.client.entity.id"
+	hmacSecretKey                           string = "scalar.dl.client.entity.identity.hmac.secret_key"
+	hmacSecretKeyVersion                    string = "scalar.dl.client.entity.identity.hmac.secret_key_version"
+	certExpiryPolicy                        string = "scalar.dl.client.cert_expiry_policy"
+	certClockSkew                           string = "scalar.dl.client.cert_clock_skew"
+)
+
+const (
+	// AuthenticationMethodDigitalSignature authenticates requests with the signatures by the private key of the certificate.
+	AuthenticationMethodDigitalSignature string = "digital-signature"
+
+	// AuthenticationMethodHMAC authenticates requests with the HMAC by the secret key shared with Scalar DL networks.
+	AuthenticationMethodHMAC string = "hmac"
+)
+
+const (
+	// CertExpiryPolicyIgnore doesn't check the validity period of the certificate.
+	CertExpiryPolicyIgnore string = "ignore"
+
+	// CertExpiryPolicyWarn passes a warning to ClientServiceOptions.OnCertificateWarning
+	// for an expired or not yet valid certificate.
+	CertExpiryPolicyWarn string = "warn"
+
+	// CertExpiryPolicyFail fails to create ClientService with an expired or not yet valid certificate.
+	CertExpiryPolicyFail string = "fail"
 )
 
 // ClientConfig defines the structure of the configurations that is used in ClientService.
 // We can use NewClientConfigFromJavaProperties to create it from Java Properties,
 // or use NewClientConfigFromJSON to create it from JSON.
+// With the hmac AuthenticationMethod, SecretKey and SecretVersion are used instead of Cert and PrivateKey,
+// and CertHolderID is the entity ID of the secret key.
+// CertExpiryPolicy tells what NewClientService does for an expired or not yet valid certificate,
+// allowing CertClockSkew between the client and the CA.
 type ClientConfig struct {
 	LedgerHost                              string `validate:"required"`
 	LedgerPort                              uint16 `validate:"lt=65536"`
 	LedgerPrivilegedPort                    uint16 `validate:"lt=65536"`
 	CertHolderID                            string `validate:"required"`
 	CertVersion                             int
-	Cert                                    string `validate:"required"`
-	PrivateKey                              string `validate:"required"`
+	Cert                                    string `validate:"required_unless=AuthenticationMethod hmac"`
+	PrivateKey                              string `validate:"required_unless=AuthenticationMethod hmac"`
 	IsTLSEnabled                            bool
 	TLSCaRootCert                           string `validate:"required_if=IsTLSEnabled true"`
 	AuthorizationCredential                 string
@@ -59,13 +93,23 @@ type ClientConfig struct {
 	AuditorTLSCaRootCert                    string `validate:"required_if=IsAuditorTLSEnabled true"`
 	IsAuditorLinearizableValidationEnabled  bool
 	AuditorLinearizableValidationContractID string `validate:"required_if=IsAuditorLinearizableValidationEnabled true"`
+	AuthenticationMethod                    string `validate:"omitempty,oneof=digital-signature hmac"`
+	SecretKey                               string `validate:"required_if=AuthenticationMethod hmac"`
+	SecretVersion                           int
+	CertExpiryPolicy                        string        `validate:"omitempty,oneof=ignore warn fail"`
+	CertClockSkew                           time.Duration `validate:"gte=0"`
 }
 
 var validate *validator.Validate = validator.New()
 
 // Validate checks if mandatory fields are assign and well-formatted.
+// The errors are ValidationErrors, which name the property keys rather than the fields.
 func (c *ClientConfig) Validate() error {
-	return validate.Struct(c)
+	if err := validate.Struct(c); err != nil {
+		return toValidationErrors(err)
+	}
+
+	return nil
 }
 
 // NewClientConfigWithDefaultValues creates ClientConfig instance with following default values.
@@ -83,6 +127,10 @@ func (c *ClientConfig) Validate() error {
 //		IsAuditorTLSEnabled:                     false,
 //		IsAuditorLinearizableValidationEnabled:  false,
 //		AuditorLinearizableValidationContractID: "validate-ledger",
+//		AuthenticationMethod:                    "digital-signature",
+//		SecretVersion:                           1,
+//		CertExpiryPolicy:                        "warn",
+//		CertClockSkew:                           5 * time.Minute,
 //	}
 func NewClientConfigWithDefaultValues() ClientConfig {
 	return ClientConfig{
@@ -99,6 +147,10 @@ func NewClientConfigWithDefaultValues() ClientConfig {
 		IsAuditorTLSEnabled:                     false,
 		IsAuditorLinearizableValidationEnabled:  false,
 		AuditorLinearizableValidationContractID: "validate-ledger",
+		AuthenticationMethod:                    AuthenticationMethodDigitalSignature,
+		SecretVersion:                           1,
+		CertExpiryPolicy:                        CertExpiryPolicyWarn,
+		CertClockSkew:                           5 * time.Minute,
 	}
 }
 
@@ -118,11 +170,111 @@ func NewClientConfigFromJSON(json string) (ClientConfig, error) {
 	return readConfigByViper(v, json)
 }
 
+// NewClientConfigFromFile loads the file at the given path to create ClientConfig, and validates it.
+// The format is detected by the extension: .properties, .json, .yaml, .yml or .toml.
+func NewClientConfigFromFile(path string) (clientConfig ClientConfig, err error) {
+	var v *viper.Viper
+
+	if v, err = newViperForFile(path); err != nil {
+		return
+	}
+
+	if err = v.ReadInConfig(); err != nil {
+		return
+	}
+
+	if clientConfig, err = readConfig(v); err != nil {
+		return
+	}
+
+	err = clientConfig.Validate()
+
+	return
+}
+
+// LoadClientConfig creates ClientConfig in layers, and validates it.
+// The values in the file at the given path override the default values,
+// and the environment variables override the values in the file.
+// The file is skipped if the path is empty.
+//
+// The environment variable of a property is its key in upper case with underscores instead of dots,
+// e.g. SCALAR_DL_CLIENT_SERVER_HOST for scalar.dl.client.server.host.
+func LoadClientConfig(path string) (clientConfig ClientConfig, err error) {
+	var v *viper.Viper = viper.New()
+
+	if path != "" {
+		if v, err = newViperForFile(path); err != nil {
+			return
+		}
+
+		if err = v.ReadInConfig(); err != nil {
+			return
+		}
+	}
+
+	v.SetEnvKeyReplacer(strings.NewReplacer(".", "_"))
+	v.AutomaticEnv()
+
+	if clientConfig, err = readConfig(v); err != nil {
+		return
+	}
+
+	err = clientConfig.Validate()
+
+	return
+}
+
+// newViperForFile creates viper for the file at the given path according to the extension.
+func newViperForFile(path string) (v *viper.Viper, err error) {
+	var configType string
+
+	switch ext := strings.ToLower(filepath.Ext(path)); ext {
+	case ".properties":
+		configType = "properties"
+	case ".json":
+		configType = "json"
+	case ".yaml", ".yml":
+		configType = "yaml"
+	case ".toml":
+		configType = "toml"
+	default:
+		return nil, fmt.Errorf("unsupported config file extension %q of %s", ext, path)
+	}
+
+	v = viper.New()
+	v.SetConfigFile(path)
+	v.SetConfigType(configType)
+
+	return
+}
+
 func readConfigByViper(v *viper.Viper, configInString string) (clientConfig ClientConfig, err error) {
 	if err = v.ReadConfig(bytes.NewBuffer([]byte(configInString))); err != nil {
 		return
 	}
 
+	return readConfig(v)
+}
+
+// readConfig creates ClientConfig from the properties in viper on top of the default values.
+// It returns ValidationErrors for the files of the path properties that can't be read.
+func readConfig(v *viper.Viper) (clientConfig ClientConfig, err error) {
+	var readFileErrors ValidationErrors
+
+	var readFile = func(field string, path string) (content string, ok bool) {
+		if path == "" {
+			return "", false
+		}
+
+		read, err := ioutil.ReadFile(path)
+		if err != nil {
+			readFileErrors = append(readFileErrors, readFileError(field, path, err))
+			return "", false
+		}
+
+		return string(read), true
+	}
+
 	clientConfig = NewClientConfigWithDefaultValues()
 
 	if v.GetString(ledgerServerHost) != "" {
@@ -137,9 +289,8 @@ func readConfigByViper(v *viper.Viper, configInString string) (clientConfig Clie
 		clientConfig.LedgerPrivilegedPort = uint16(v.GetUint(ledgerServerPriviledgedPort))
 	}
 
-	var certPath string = v.GetString(certPath)
-	if certBytes, err := ioutil.ReadFile(certPath); err == nil {
-		clientConfig.Cert = string(certBytes)
+	if cert, ok := readFile("Cert", v.GetString(certPath)); ok {
+		clientConfig.Cert = cert
 	}
 
 	var certPem string = v.GetString(certPem)
@@ -149,13 +300,16 @@ func readConfigByViper(v *viper.Viper, configInString string) (clientConfig Clie
 
 	clientConfig.CertHolderID = v.GetString(certHolderID)
 
+	if v.GetString(entityID) != "" {
+		clientConfig.CertHolderID = v.GetString(entityID)
+	}
+
 	if v.GetInt(certVersion) != 0 {
 		clientConfig.CertVersion = v.GetInt(certVersion)
 	}
 
-	var privateKeyPath string = v.GetString(privateKeyPath)
-	if privateKeyBytes, err := ioutil.ReadFile(privateKeyPath); err == nil {
-		clientConfig.PrivateKey = string(privateKeyBytes)
+	if privateKey, ok := readFile("PrivateKey", v.GetString(privateKeyPath)); ok {
+		clientConfig.PrivateKey = privateKey
 	}
 
 	var privateKeyPem string = v.GetString(privateKeyPem)
@@ -165,13 +319,12 @@ func readConfigByViper(v *viper.Viper, configInString string) (clientConfig Clie
 
 	clientConfig.IsTLSEnabled = v.GetBool(tlsEnabled)
 
-	var path string = v.GetString(tlsCaRootCertPath)
-	if tlsCaRootCertBytes, err := ioutil.ReadFile(path); err == nil {
-		clientConfig.TLSCaRootCert = string(tlsCaRootCertBytes)
+	if tlsCaRootCert, ok := readFile("TLSCaRootCert", v.GetString(tlsCaRootCertPath)); ok {
+		clientConfig.TLSCaRootCert = tlsCaRootCert
 	}
 
 	var pem string = v.GetString(tlsCaRootCertPem)
-	if tlsCaRootCertPem != "" {
+	if pem != "" {
 		clientConfig.TLSCaRootCert = pem
 	}
 
@@ -203,9 +356,8 @@ func readConfigByViper(v *viper.Viper, configInString string) (clientConfig Clie
 
 	clientConfig.IsAuditorTLSEnabled = v.GetBool(auditorTLSEnabled)
 
-	path = v.GetString(auditorTLSCaRootCertPath)
-	if auditorTLSCaRootCertBytes, err := ioutil.ReadFile(path); err == nil {
-		clientConfig.AuditorTLSCaRootCert = string(auditorTLSCaRootCertBytes)
+	if auditorTLSCaRootCert, ok := readFile("AuditorTLSCaRootCert", v.GetString(auditorTLSCaRootCertPath)); ok {
+		clientConfig.AuditorTLSCaRootCert = auditorTLSCaRootCert
 	}
 
 	pem = v.GetString(auditorTLSCaRootCertPem)
@@ -213,6 +365,24 @@ func readConfigByViper(v *viper.Viper, configInString string) (clientConfig Clie
 		clientConfig.AuditorTLSCaRootCert = pem
 	}
 
+	if v.GetString(authenticationMethod) != "" {
+		clientConfig.AuthenticationMethod = v.GetString(authenticationMethod)
+	}
+
+	clientConfig.SecretKey = v.GetString(hmacSecretKey)
+
+	if v.GetInt(hmacSecretKeyVersion) != 0 {
+		clientConfig.SecretVersion = v.GetInt(hmacSecretKeyVersion)
+	}
+
+	if v.GetString(certExpiryPolicy) != "" {
+		clientConfig.CertExpiryPolicy = v.GetString(certExpiryPolicy)
+	}
+
+	if v.IsSet(certClockSkew) {
+		clientConfig.CertClockSkew = v.GetDuration(certClockSkew)
+	}
+
 	if clientConfig.IsAuditorEnabled {
 		clientConfig.IsAuditorLinearizableValidationEnabled = v.GetBool(auditorLinearizableValidationEnabled)
 		if v.GetString(auditorLinearizableValidationContractID) != "" {
@@ -220,5 +390,9 @@ func readConfigByViper(v *viper.Viper, configInString string) (clientConfig Clie
 		}
 	}
 
+	if len(readFileErrors) > 0 {
+		err = readFileErrors
+	}
+
 	return
 }
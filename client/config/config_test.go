@@ -1,6 +1,12 @@
 package config
 
-import "testing"
+import (
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
 
 func TestNewClientConfigFromJSON(t *testing.T) {
 	var (
@@ -212,6 +218,40 @@ func TestNewClientConfigFromJSON(t *testing.T) {
 	}
 }
 
+func TestNewClientConfigFromJSONWithHMAC(t *testing.T) {
+	var json = `
+{
+	"scalar.dl.client.authentication.method": "hmac",
+	"scalar.dl.client.entity.id": "foo",
+	"scalar.dl.client.entity.identity.hmac.secret_key": "s3cr3t",
+	"scalar.dl.client.entity.identity.hmac.secret_key_version": 2
+}
+`
+
+	c, err := NewClientConfigFromJSON(json)
+	if err != nil {
+		t.Errorf("can't load JSON %s", json)
+	}
+
+	if c.AuthenticationMethod != AuthenticationMethodHMAC || c.CertHolderID != "foo" || c.SecretKey != "s3cr3t" || c.SecretVersion != 2 {
+		t.Errorf("HMAC configurations are not match")
+	}
+
+	if err = c.Validate(); err != nil {
+		t.Errorf("should be validated without Cert and PrivateKey: %v", err)
+	}
+
+	c.SecretKey = ""
+	if err = c.Validate(); err == nil {
+		t.Errorf("should not be validated without SecretKey")
+	}
+
+	c.AuthenticationMethod = "invalid"
+	if err = c.Validate(); err == nil {
+		t.Errorf("should not be validated with invalid AuthenticationMethod")
+	}
+}
+
 func TestNewClientConfigFromJavaProperties(t *testing.T) {
 	var javaProperties = `
 scalar.dl.client.server.host=localhost
@@ -313,3 +353,224 @@ scalar.dl.client.auditor.linearizable_validation.contract_id=linearizable
 		t.Errorf("AuditorLinearizableValidationContractID is not match")
 	}
 }
+
+func TestNewClientConfigFromJavaPropertiesWithCertExpiryPolicy(t *testing.T) {
+	c, err := NewClientConfigFromJavaProperties(`
+scalar.dl.client.cert_holder_id=foo
+scalar.dl.client.cert_pem=cert_pem
+scalar.dl.client.private_key_pem=private_key_pem
+scalar.dl.client.cert_expiry_policy=fail
+scalar.dl.client.cert_clock_skew=30s
+`)
+	if err != nil {
+		t.Errorf("can't load Java Properties")
+	}
+
+	if c.CertExpiryPolicy != CertExpiryPolicyFail || c.CertClockSkew != 30*time.Second {
+		t.Errorf("CertExpiryPolicy and CertClockSkew are not match")
+	}
+
+	c.CertExpiryPolicy = "invalid"
+	if err = c.Validate(); err == nil {
+		t.Errorf("should not be validated with invalid CertExpiryPolicy")
+	}
+}
+
+func TestNewClientConfigFromFile(t *testing.T) {
+	var (
+		dir   = t.TempDir()
+		files = map[string]string{
+			"client.properties": `
+scalar.dl.client.server.host=ledger
+scalar.dl.client.cert_holder_id=foo
+scalar.dl.client.cert_pem=cert_pem
+scalar.dl.client.private_key_pem=private_key_pem
+`,
+			"client.json": `
+{
+	"scalar.dl.client.server.host": "ledger",
+	"scalar.dl.client.cert_holder_id": "foo",
+	"scalar.dl.client.cert_pem": "cert_pem",
+	"scalar.dl.client.private_key_pem": "private_key_pem"
+}
+`,
+			"client.yaml": `
+scalar.dl.client.server.host: ledger
+scalar.dl.client.cert_holder_id: foo
+scalar.dl.client.cert_pem: cert_pem
+scalar.dl.client.private_key_pem: private_key_pem
+`,
+			"client.toml": `
+"scalar.dl.client.server.host" = "ledger"
+"scalar.dl.client.cert_holder_id" = "foo"
+"scalar.dl.client.cert_pem" = "cert_pem"
+"scalar.dl.client.private_key_pem" = "private_key_pem"
+`,
+		}
+	)
+
+	for name, content := range files {
+		var path = filepath.Join(dir, name)
+		if err := ioutil.WriteFile(path, []byte(content), 0600); err != nil {
+			t.Fatal(err)
+		}
+
+		c, err := NewClientConfigFromFile(path)
+		if err != nil {
+			t.Errorf("should load %s: %v", name, err)
+		}
+
+		if c.LedgerHost != "ledger" || c.CertHolderID != "foo" || c.Cert != "cert_pem" || c.LedgerPort != 50051 {
+			t.Errorf("ClientConfig from %s is not match", name)
+		}
+	}
+
+	var invalid = filepath.Join(dir, "invalid.json")
+	if err := ioutil.WriteFile(invalid, []byte(`{"scalar.dl.client.cert_holder_id": "foo"}`), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := NewClientConfigFromFile(invalid); err == nil {
+		t.Errorf("should be validated")
+	}
+
+	if _, err := NewClientConfigFromFile(filepath.Join(dir, "client.txt")); err == nil {
+		t.Errorf("should reject an unsupported extension")
+	}
+
+	if _, err := NewClientConfigFromFile(filepath.Join(dir, "missing.json")); err == nil {
+		t.Errorf("should fail for a missing file")
+	}
+}
+
+func TestLoadClientConfig(t *testing.T) {
+	var path = filepath.Join(t.TempDir(), "client.properties")
+	if err := ioutil.WriteFile(path, []byte(`
+scalar.dl.client.server.host=ledger
+scalar.dl.client.server.port=80
+scalar.dl.client.cert_holder_id=foo
+scalar.dl.client.cert_pem=cert_pem
+scalar.dl.client.private_key_pem=private_key_pem
+`), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	t.Setenv("SCALAR_DL_CLIENT_SERVER_HOST", "overridden")
+	t.Setenv("SCALAR_DL_CLIENT_AUDITOR_ENABLED", "true")
+
+	c, err := LoadClientConfig(path)
+	if err != nil {
+		t.Errorf("should load the config: %v", err)
+	}
+
+	if c.LedgerHost != "overridden" {
+		t.Errorf("environment variables should override the file")
+	}
+
+	if c.LedgerPort != 80 || c.CertHolderID != "foo" {
+		t.Errorf("the values in the file should be kept")
+	}
+
+	if !c.IsAuditorEnabled || c.AuditorHost != "localhost" {
+		t.Errorf("environment variables should override the default values")
+	}
+
+	if _, err = LoadClientConfig(""); err == nil {
+		t.Errorf("should be validated without the file")
+	}
+
+	t.Setenv("SCALAR_DL_CLIENT_CERT_HOLDER_ID", "bar")
+	t.Setenv("SCALAR_DL_CLIENT_CERT_PEM", "cert_pem")
+	t.Setenv("SCALAR_DL_CLIENT_PRIVATE_KEY_PEM", "private_key_pem")
+
+	if c, err = LoadClientConfig(""); err != nil || c.CertHolderID != "bar" {
+		t.Errorf("should load the config only from environment variables: %v", err)
+	}
+}
+
+func TestValidationErrors(t *testing.T) {
+	var c = NewClientConfigWithDefaultValues()
+	c.CertHolderID = "foo"
+	c.PrivateKey = "private_key_pem"
+
+	var err = c.Validate()
+
+	validationErrors, ok := err.(ValidationErrors)
+	if !ok || len(validationErrors) != 1 {
+		t.Fatalf("should return ValidationErrors: %v", err)
+	}
+
+	if validationErrors[0].Field != "Cert" || validationErrors[0].Key != certPem || validationErrors[0].PathKey != certPath {
+		t.Errorf("should name the property keys of Cert: %+v", validationErrors[0])
+	}
+
+	var expected = "scalar.dl.client.cert_pem (or scalar.dl.client.cert_path) is required unless scalar.dl.client.authentication.method is hmac"
+	if err.Error() != expected {
+		t.Errorf("should be actionable: %s", err.Error())
+	}
+
+	c.Cert = "cert_pem"
+	c.CertHolderID = ""
+	c.CertExpiryPolicy = "invalid"
+
+	if validationErrors, ok = c.Validate().(ValidationErrors); !ok || len(validationErrors) != 2 {
+		t.Errorf("should return all the invalid properties: %v", validationErrors)
+	}
+
+	var missing = filepath.Join(t.TempDir(), "missing.pem")
+	_, err = NewClientConfigFromJavaProperties(`
+scalar.dl.client.cert_holder_id=foo
+scalar.dl.client.cert_path=` + missing + `
+scalar.dl.client.private_key_pem=private_key_pem
+`)
+
+	if validationErrors, ok = err.(ValidationErrors); !ok || len(validationErrors) != 1 || validationErrors[0].Key != certPath {
+		t.Errorf("should report the unreadable cert_path: %v", err)
+	}
+}
+
+func TestReadTLSCaRootCertPem(t *testing.T) {
+	c, err := NewClientConfigFromJavaProperties(`
+scalar.dl.client.cert_holder_id=foo
+scalar.dl.client.cert_pem=cert_pem
+scalar.dl.client.private_key_pem=private_key_pem
+scalar.dl.client.tls.enabled=true
+scalar.dl.client.tls.ca_root_cert_pem=ca_root_cert_pem
+`)
+	if err != nil || c.TLSCaRootCert != "ca_root_cert_pem" {
+		t.Errorf("should read tls.ca_root_cert_pem: %v", err)
+	}
+}
+
+func TestExport(t *testing.T) {
+	var c = NewClientConfigWithDefaultValues()
+	c.CertHolderID = "foo"
+	c.Cert = "-----BEGIN CERTIFICATE-----\ncert\n-----END CERTIFICATE-----\n"
+	c.PrivateKey = "s3cr3t"
+	c.AuthorizationCredential = "credential"
+	c.IsAuditorEnabled = true
+	c.CertClockSkew = 10 * time.Second
+
+	var redacted = c.Redacted()
+	if redacted.PrivateKey != RedactedValue || redacted.AuthorizationCredential != RedactedValue || redacted.SecretKey != "" {
+		t.Errorf("should mask the secrets")
+	}
+
+	if redacted.Cert != c.Cert || c.PrivateKey != "s3cr3t" {
+		t.Errorf("should keep the other values and the original config")
+	}
+
+	if strings.Contains(c.ToJSON(), "s3cr3t") || strings.Contains(c.ToProperties(), "s3cr3t") {
+		t.Errorf("should not export the secrets")
+	}
+
+	fromJSON, err := NewClientConfigFromJSON(c.ToJSON())
+	if err != nil || fromJSON != redacted {
+		t.Errorf("should parse the JSON back to the redacted config: %v", err)
+	}
+
+	fromProperties, err := NewClientConfigFromJavaProperties(c.ToProperties())
+	if err != nil || fromProperties != redacted {
+		t.Errorf("should parse the properties back to the redacted config: %v", err)
+	}
+}
@@ -0,0 +1,104 @@
+package config
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"github.com/magiconair/properties"
+)
+
+// RedactedValue replaces the secrets in the redacted ClientConfig.
+const RedactedValue string = "<redacted>"
+
+// Redacted returns a copy of the config with the secrets masked by RedactedValue:
+// PrivateKey, SecretKey and AuthorizationCredential. Empty secrets are kept empty.
+func (c ClientConfig) Redacted() ClientConfig {
+	for _, secret := range []*string{&c.PrivateKey, &c.SecretKey, &c.AuthorizationCredential} {
+		if *secret != "" {
+			*secret = RedactedValue
+		}
+	}
+
+	return c
+}
+
+// ToProperties exports the config in Java Properties with the secrets masked.
+// NewClientConfigFromJavaProperties parses it back to the redacted config.
+func (c ClientConfig) ToProperties() string {
+	var p = properties.NewProperties()
+
+	for _, property := range c.Redacted().toProperties() {
+		p.Set(property.key, property.value)
+	}
+
+	var buffer bytes.Buffer
+	p.Write(&buffer, properties.UTF8)
+
+	return buffer.String()
+}
+
+// ToJSON exports the config in JSON with the secrets masked.
+// NewClientConfigFromJSON parses it back to the redacted config.
+func (c ClientConfig) ToJSON() string {
+	var object = make(map[string]interface{})
+
+	for _, property := range c.Redacted().toProperties() {
+		object[property.key] = property.json
+	}
+
+	exported, _ := json.MarshalIndent(object, "", "  ")
+
+	return string(exported)
+}
+
+// exportedProperty defines a property of the exported config in both formats.
+type exportedProperty struct {
+	key   string
+	value string
+	json  interface{}
+}
+
+// toProperties lists the properties that the parsers read back to the config.
+func (c ClientConfig) toProperties() (exported []exportedProperty) {
+	var add = func(key string, value interface{}) {
+		var formatted string
+
+		switch v := value.(type) {
+		case string:
+			formatted = v
+		default:
+			encoded, _ := json.Marshal(v)
+			formatted = string(encoded)
+		}
+
+		exported = append(exported, exportedProperty{key: key, value: formatted, json: value})
+	}
+
+	add(ledgerServerHost, c.LedgerHost)
+	add(ledgerServerPort, c.LedgerPort)
+	add(ledgerServerPriviledgedPort, c.LedgerPrivilegedPort)
+	add(certHolderID, c.CertHolderID)
+	add(certVersion, c.CertVersion)
+	add(certPem, c.Cert)
+	add(privateKeyPem, c.PrivateKey)
+	add(tlsEnabled, c.IsTLSEnabled)
+	add(tlsCaRootCertPem, c.TLSCaRootCert)
+	add(authorizationCredential, c.AuthorizationCredential)
+	add(clientMode, c.ClientMode)
+	add(proxyServer, c.ProxyServer)
+	add(auditorEnabled, c.IsAuditorEnabled)
+	add(auditorServerHost, c.AuditorHost)
+	add(auditorServerPort, c.AuditorPort)
+	add(auditorServerPriviledgedPort, c.AuditorPrivilegedPort)
+	add(auditorTLSEnabled, c.IsAuditorTLSEnabled)
+	add(auditorTLSCaRootCertPem, c.AuditorTLSCaRootCert)
+	add(auditorLinearizableValidationEnabled, c.IsAuditorLinearizableValidationEnabled)
+	add(auditorLinearizableValidationContractID, c.AuditorLinearizableValidationContractID)
+	add(authenticationMethod, c.AuthenticationMethod)
+	add(hmacSecretKey, c.SecretKey)
+	add(hmacSecretKeyVersion, c.SecretVersion)
+	add(certExpiryPolicy, c.CertExpiryPolicy)
+	add(certClockSkew, c.CertClockSkew.String())
+
+	return
+}
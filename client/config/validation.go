@@ -0,0 +1,140 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// ValidationError describes an invalid property of ClientConfig in terms of the property keys users write.
+type ValidationError struct {
+	// Field is the field of ClientConfig.
+	Field string
+
+	// Key is the property key, e.g. scalar.dl.client.cert_pem.
+	Key string
+
+	// PathKey is the property key to read the value from a file instead, e.g. scalar.dl.client.cert_path.
+	PathKey string
+
+	// Reason tells what is wrong with the property.
+	Reason string
+}
+
+func (e ValidationError) Error() string {
+	if e.PathKey != "" {
+		return fmt.Sprintf("%s (or %s) %s", e.Key, e.PathKey, e.Reason)
+	}
+
+	return fmt.Sprintf("%s %s", e.Key, e.Reason)
+}
+
+// ValidationErrors is the list of the invalid properties of ClientConfig.
+type ValidationErrors []ValidationError
+
+func (e ValidationErrors) Error() string {
+	var messages = make([]string, 0, len(e))
+
+	for _, err := range e {
+		messages = append(messages, err.Error())
+	}
+
+	return strings.Join(messages, "; ")
+}
+
+// property defines the property keys of a field of ClientConfig.
+type property struct {
+	key     string
+	pathKey string
+}
+
+var fieldProperties = map[string]property{
+	"LedgerHost":                             {key: ledgerServerHost},
+	"LedgerPort":                             {key: ledgerServerPort},
+	"LedgerPrivilegedPort":                   {key: ledgerServerPriviledgedPort},
+	"CertHolderID":                           {key: certHolderID},
+	"CertVersion":                            {key: certVersion},
+	"Cert":                                   {key: certPem, pathKey: certPath},
+	"PrivateKey":                             {key: privateKeyPem, pathKey: privateKeyPath},
+	"IsTLSEnabled":                           {key: tlsEnabled},
+	"TLSCaRootCert":                          {key: tlsCaRootCertPem, pathKey: tlsCaRootCertPath},
+	"AuthorizationCredential":                {key: authorizationCredential},
+	"ClientMode":                             {key: clientMode},
+	"ProxyServer":                            {key: proxyServer},
+	"IsAuditorEnabled":                       {key: auditorEnabled},
+	"AuditorHost":                            {key: auditorServerHost},
+	"AuditorPort":                            {key: auditorServerPort},
+	"AuditorPrivilegedPort":                  {key: auditorServerPriviledgedPort},
+	"IsAuditorTLSEnabled":                    {key: auditorTLSEnabled},
+	"AuditorTLSCaRootCert":                   {key: auditorTLSCaRootCertPem, pathKey: auditorTLSCaRootCertPath},
+	"IsAuditorLinearizableValidationEnabled": {key: auditorLinearizableValidationEnabled},
+	"AuditorLinearizableValidationContractID": {key: auditorLinearizableValidationContractID},
+	"AuthenticationMethod":                    {key: authenticationMethod},
+	"SecretKey":                               {key: hmacSecretKey},
+	"SecretVersion":                           {key: hmacSecretKeyVersion},
+	"CertExpiryPolicy":                        {key: certExpiryPolicy},
+	"CertClockSkew":                           {key: certClockSkew},
+}
+
+// toValidationErrors converts the errors of the validator to ValidationErrors.
+func toValidationErrors(err error) error {
+	var fieldErrors validator.ValidationErrors
+
+	if !errors.As(err, &fieldErrors) {
+		return err
+	}
+
+	var validationErrors = make(ValidationErrors, 0, len(fieldErrors))
+
+	for _, fieldError := range fieldErrors {
+		var p = fieldProperties[fieldError.StructField()]
+
+		validationErrors = append(validationErrors, ValidationError{
+			Field:   fieldError.StructField(),
+			Key:     p.key,
+			PathKey: p.pathKey,
+			Reason:  toReason(fieldError),
+		})
+	}
+
+	return validationErrors
+}
+
+// toReason describes the failed validation tag with the property keys.
+func toReason(fieldError validator.FieldError) string {
+	switch fieldError.Tag() {
+	case "required":
+		return "is required"
+	case "required_if", "required_unless":
+		var condition = "when"
+		if fieldError.Tag() == "required_unless" {
+			condition = "unless"
+		}
+
+		params := strings.SplitN(fieldError.Param(), " ", 2)
+		if len(params) == 2 {
+			return fmt.Sprintf("is required %s %s is %s", condition, fieldProperties[params[0]].key, params[1])
+		}
+
+		return "is required"
+	case "oneof":
+		return fmt.Sprintf("must be one of %s, but it is %v", strings.Join(strings.Fields(fieldError.Param()), ", "), fieldError.Value())
+	case "lt":
+		return fmt.Sprintf("must be less than %s, but it is %v", fieldError.Param(), fieldError.Value())
+	case "gte":
+		return fmt.Sprintf("must be greater than or equal to %s, but it is %v", fieldError.Param(), fieldError.Value())
+	default:
+		return fmt.Sprintf("is invalid (%s)", fieldError.Tag())
+	}
+}
+
+// readFileError describes the file of the path property of the given field that can't be read.
+func readFileError(field string, path string, err error) ValidationError {
+	return ValidationError{
+		Field:  field,
+		Key:    fieldProperties[field].pathKey,
+		Reason: fmt.Sprintf("cannot be read from %s: %v", path, err),
+	}
+}
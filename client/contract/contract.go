@@ -0,0 +1,225 @@
+// Package contract provides typed contract executions on top of service.Client.
+// The arguments are Go values encoded to JSON objects, so the json tags of the structs apply,
+// and the results are decoded into Go values in the same way:
+//
+//	type Transfer struct {
+//		From   string `json:"from"`
+//		To     string `json:"to"`
+//		Amount int64  `json:"amount"`
+//	}
+//
+//	type Balances struct {
+//		From int64 `json:"from_balance"`
+//		To   int64 `json:"to_balance"`
+//	}
+//
+//	transfer := contract.NewHandle(clientService, "transfer", Transfer{}, Balances{})
+//
+//	var balances Balances
+//	_, err = transfer.Execute(Transfer{From: "alice", To: "bob", Amount: 100}, &balances)
+//
+// A Handle checks the types of the arguments and the results at runtime,
+// so that a contract called from many places is always called with the same types.
+package contract
+
+import (
+	"bytes"
+	ej "encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/scalar-labs/scalardl-go-client-sdk/v3/client/service"
+	"github.com/scalar-labs/scalardl-go-client-sdk/v3/json"
+	"github.com/scalar-labs/scalardl-go-client-sdk/v3/ledger/model"
+)
+
+// ToObject encodes the value, such as a struct with json tags or a map, to json.Object.
+// It fails if the value is not encoded to a JSON object. A nil value results in an empty json.Object.
+// The numbers are kept as json.Number, so large integers are sent without losing precision.
+func ToObject(v interface{}) (json.Object, error) {
+	if o, ok := v.(json.Object); ok {
+		return o, nil
+	}
+
+	if isNil(v) {
+		return json.Object{}, nil
+	}
+
+	encoded, err := ej.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		o       json.Object
+		decoder = ej.NewDecoder(bytes.NewReader(encoded))
+	)
+
+	decoder.UseNumber()
+
+	if err = decoder.Decode(&o); err != nil || o == nil {
+		return nil, fmt.Errorf("%T must be encoded as a JSON object", v)
+	}
+
+	return o, nil
+}
+
+// FromObject decodes the json.Object into the value that v points to, as encoding/json does.
+func FromObject(o json.Object, v interface{}) error {
+	encoded, err := ej.Marshal(o)
+	if err != nil {
+		return err
+	}
+
+	return ej.Unmarshal(encoded, v)
+}
+
+// Execute executes the contract with the argument encoded by ToObject,
+// and decodes the result into the value that result points to unless result is nil.
+// The result is decoded from RawResult of the execution, so large integers keep their precision,
+// and the numbers in interface{} values are json.Number.
+// The execution is not undone if the result can't be decoded, and the error comes with its result,
+// which also tells the nonce of the execution.
+func Execute(client service.Client, id string, argument interface{}, result interface{}) (model.ContractExecutionResult, error) {
+	return ExecuteWithOptions(client, id, argument, nil, service.ContractExecutionOptions{}, result)
+}
+
+// ExecuteWithOptions executes the contract with the argument and the function argument encoded by ToObject,
+// and decodes the result into the value that result points to unless result is nil.
+// The function argument is not sent if it is nil.
+func ExecuteWithOptions(
+	client service.Client,
+	id string,
+	argument interface{},
+	functionArgument interface{},
+	options service.ContractExecutionOptions,
+	result interface{},
+) (executed model.ContractExecutionResult, err error) {
+	a, err := ToObject(argument)
+	if err != nil {
+		return executed, fmt.Errorf("the argument of %s: %w", id, err)
+	}
+
+	var f json.Object
+
+	if !isNil(functionArgument) {
+		if f, err = ToObject(functionArgument); err != nil {
+			return executed, fmt.Errorf("the function argument of %s: %w", id, err)
+		}
+	}
+
+	if executed, err = client.ExecuteContractWithOptions(id, a, f, options); err != nil {
+		return
+	}
+
+	if result != nil {
+		if err = decodeResult(executed, result); err != nil {
+			return executed, fmt.Errorf("the result of %s can't be decoded: %w", id, err)
+		}
+	}
+
+	return
+}
+
+// decodeResult decodes RawResult of the execution with the numbers kept as json.Number.
+// Result is decoded instead if RawResult is empty, e.g. when the execution is given by a mock client.
+func decodeResult(executed model.ContractExecutionResult, result interface{}) error {
+	if executed.RawResult == "" {
+		if executed.Result == nil {
+			return nil
+		}
+
+		return FromObject(executed.Result, result)
+	}
+
+	var decoder = ej.NewDecoder(strings.NewReader(executed.RawResult))
+
+	decoder.UseNumber()
+
+	return decoder.Decode(result)
+}
+
+// Handle is a contract bound to its ID and a client, with the types of its arguments and its results.
+// It is a small value that can be shared by goroutines.
+type Handle struct {
+	client       service.Client
+	id           string
+	argumentType reflect.Type
+	resultType   reflect.Type
+}
+
+// NewHandle creates Handle of the contract with the types of the argument and the result,
+// e.g. NewHandle(client, "transfer", Transfer{}, Balances{}). A nil argument or result leaves the type unchecked.
+func NewHandle(client service.Client, id string, argument interface{}, result interface{}) Handle {
+	var h = Handle{client: client, id: id}
+
+	if argument != nil {
+		h.argumentType = reflect.TypeOf(argument)
+	}
+
+	if result != nil {
+		h.resultType = reflect.TypeOf(result)
+	}
+
+	return h
+}
+
+// ID returns the contract ID.
+func (h Handle) ID() string {
+	return h.id
+}
+
+// WithClient returns a copy of the handle that executes the contract with the client, e.g. on behalf of another identity.
+func (h Handle) WithClient(client service.Client) Handle {
+	h.client = client
+	return h
+}
+
+// Execute executes the contract with the argument, and decodes the result into the value that result points to unless result is nil.
+// The argument must be of the argument type of the handle, and result must point to a value of the result type.
+func (h Handle) Execute(argument interface{}, result interface{}) (model.ContractExecutionResult, error) {
+	return h.ExecuteWithOptions(argument, nil, service.ContractExecutionOptions{}, result)
+}
+
+// ExecuteWithOptions executes the contract with the argument, the function argument and the options,
+// and decodes the result into the value that result points to unless result is nil.
+func (h Handle) ExecuteWithOptions(
+	argument interface{},
+	functionArgument interface{},
+	options service.ContractExecutionOptions,
+	result interface{},
+) (executed model.ContractExecutionResult, err error) {
+	if err = h.check(argument, result); err != nil {
+		return
+	}
+
+	return ExecuteWithOptions(h.client, h.id, argument, functionArgument, options, result)
+}
+
+// check checks the types of the argument and the result.
+func (h Handle) check(argument interface{}, result interface{}) error {
+	if h.argumentType != nil && reflect.TypeOf(argument) != h.argumentType {
+		return fmt.Errorf("the argument of %s must be %s rather than %T", h.id, h.argumentType, argument)
+	}
+
+	if h.resultType != nil && result != nil && reflect.TypeOf(result) != reflect.PtrTo(h.resultType) {
+		return fmt.Errorf("the result of %s must be decoded into *%s rather than %T", h.id, h.resultType, result)
+	}
+
+	return nil
+}
+
+// isNil checks if the value is nil or a nil pointer, map or slice.
+func isNil(v interface{}) bool {
+	if v == nil {
+		return true
+	}
+
+	switch value := reflect.ValueOf(v); value.Kind() {
+	case reflect.Ptr, reflect.Map, reflect.Slice, reflect.Interface:
+		return value.IsNil()
+	}
+
+	return false
+}
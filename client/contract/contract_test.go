@@ -0,0 +1,173 @@
+package contract
+
+import (
+	ej "encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/scalar-labs/scalardl-go-client-sdk/v3/client/service"
+	"github.com/scalar-labs/scalardl-go-client-sdk/v3/client/service/mock"
+	"github.com/scalar-labs/scalardl-go-client-sdk/v3/json"
+	"github.com/scalar-labs/scalardl-go-client-sdk/v3/ledger/model"
+	"github.com/scalar-labs/scalardl-go-client-sdk/v3/ledger/statuscode"
+	"github.com/scalar-labs/scalardl-go-client-sdk/v3/scalardltest"
+)
+
+type transfer struct {
+	From   string `json:"from"`
+	To     string `json:"to"`
+	Amount int64  `json:"amount"`
+	Memo   string `json:"memo,omitempty"`
+}
+
+type balances struct {
+	From int64 `json:"from_balance"`
+	To   int64 `json:"to_balance"`
+}
+
+func TestToObject(t *testing.T) {
+	o, err := ToObject(transfer{From: "alice", To: "bob", Amount: 9007199254740993})
+	if err != nil || o.String() != `{"amount":9007199254740993,"from":"alice","to":"bob"}` {
+		t.Errorf("should encode the struct with the json tags and the exact integers: %v %v", o, err)
+	}
+
+	if o, err = ToObject(nil); err != nil || o == nil || len(o) != 0 {
+		t.Errorf("should encode nil as an empty object: %v %v", o, err)
+	}
+
+	var original = json.Object{"a": 1}
+	if o, err = ToObject(original); err != nil || o.String() != original.String() {
+		t.Errorf("should return json.Object as it is: %v %v", o, err)
+	}
+
+	for _, v := range []interface{}{[]int{1}, "text", 1, make(chan int)} {
+		if _, err = ToObject(v); err == nil {
+			t.Errorf("should fail for %T", v)
+		}
+	}
+
+	var b balances
+	if err = FromObject(json.Object{"from_balance": 900.0, "to_balance": 1100.0}, &b); err != nil || b != (balances{900, 1100}) {
+		t.Errorf("should decode json.Object into the struct: %v %v", b, err)
+	}
+}
+
+func TestHandle(t *testing.T) {
+	var (
+		client = mock.NewClient(t)
+		h      = NewHandle(client, "transfer", transfer{}, balances{})
+	)
+
+	client.On("ExecuteContractWithOptions", "transfer", json.Object{"from": "alice", "to": "bob", "amount": 100}, nil, mock.Any).
+		Return(model.ContractExecutionResult{Result: json.Object{"from_balance": 900.0, "to_balance": 1100.0}})
+	client.On("ExecuteContractWithOptions", "transfer", mock.Any, json.Object{"tag": "x"}, service.ContractExecutionOptions{PreExecution: true}).
+		Return(model.ContractExecutionResult{Result: json.Object{"from_balance": "many"}})
+	client.On("ExecuteContractWithOptions", "transfer", json.Object{"from": "alice", "to": "carol", "amount": 1}, nil, mock.Any).
+		Fail(statuscode.ContractContextualError, "insufficient balance")
+
+	if h.ID() != "transfer" {
+		t.Errorf("should return the contract ID: %s", h.ID())
+	}
+
+	var b balances
+	if _, err := h.Execute(transfer{From: "alice", To: "bob", Amount: 100}, &b); err != nil || b != (balances{900, 1100}) {
+		t.Errorf("should execute the contract and decode the result: %v %v", b, err)
+	}
+
+	executed, err := h.ExecuteWithOptions(transfer{}, map[string]string{"tag": "x"}, service.ContractExecutionOptions{PreExecution: true}, &b)
+	if err == nil || !strings.Contains(err.Error(), "can't be decoded") || executed.Result == nil {
+		t.Errorf("should return the executed result with the decoding error: %v %v", executed, err)
+	}
+
+	if _, err = h.Execute(transfer{From: "alice", To: "carol", Amount: 1}, nil); err == nil {
+		t.Errorf("should return the error of the execution")
+	}
+
+	if _, err = h.Execute(map[string]interface{}{"amount": 1}, &b); err == nil {
+		t.Errorf("should reject the argument of another type")
+	}
+
+	var wrong transfer
+	if _, err = h.Execute(transfer{}, &wrong); err == nil {
+		t.Errorf("should reject the result of another type")
+	}
+
+	client.Verify()
+
+	if len(client.Calls()) != 3 {
+		t.Errorf("should not execute the contract with the wrong types: %v", client.Calls())
+	}
+}
+
+func TestExecute(t *testing.T) {
+	var client = mock.NewClient(t)
+
+	client.On("ExecuteContractWithOptions", "any", json.Object{"x": 1}, nil, mock.Any).
+		Return(model.ContractExecutionResult{Result: json.Object{"y": 2.0}})
+
+	var result struct {
+		Y int `json:"y"`
+	}
+
+	if _, err := Execute(client, "any", map[string]int{"x": 1}, &result); err != nil || result.Y != 2 {
+		t.Errorf("should execute the contract without a handle: %v %v", result, err)
+	}
+
+	if _, err := Execute(client, "any", []int{1}, nil); err == nil {
+		t.Errorf("should not execute the contract with a non-object argument")
+	}
+
+	client.On("ExecuteContractWithOptions", "large", json.Object{}, nil, mock.Any).
+		Return(model.ContractExecutionResult{
+			Result:    json.Object{"from_balance": 9007199254740992.0, "to_balance": 1.0},
+			RawResult: `{"from_balance": 9007199254740993, "to_balance": 1}`,
+		})
+
+	var b balances
+
+	if _, err := Execute(client, "large", nil, &b); err != nil || b.From != 9007199254740993 {
+		t.Errorf("should decode the large integers in the result without losing precision: %v %v", b, err)
+	}
+
+	client.Verify()
+}
+
+func TestExecute_Nonce(t *testing.T) {
+	server, err := scalardltest.NewServer(scalardltest.Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer server.Close()
+
+	s, err := server.NewClientService("alice")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Close()
+
+	if err = scalardltest.RegisterCounter(s); err != nil {
+		t.Fatal(err)
+	}
+
+	type count struct {
+		AssetID string `json:"asset_id"`
+		Amount  int    `json:"amount"`
+		Nonce   string `json:"nonce,omitempty"`
+	}
+
+	var result map[string]interface{}
+
+	executed, err := Execute(s, scalardltest.CounterID, count{AssetID: "a", Amount: 1}, &result)
+	if err != nil || executed.Nonce == "" || result["balance"] != ej.Number("1") {
+		t.Fatalf("should return the nonce added to the argument: %v %v %v", executed, result, err)
+	}
+
+	if _, err = s.AbortExecution(executed.Nonce); err != nil {
+		t.Errorf("should abort the execution by the returned nonce: %v", err)
+	}
+
+	executed, err = Execute(s, scalardltest.CounterID, count{AssetID: "a", Amount: 1, Nonce: "given"}, nil)
+	if err != nil || executed.Nonce != "given" {
+		t.Errorf("should return the given nonce: %v %v", executed, err)
+	}
+}
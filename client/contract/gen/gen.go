@@ -0,0 +1,635 @@
+// Package gen generates typed Go clients of the contracts declared in a manifest.
+// For each contract, it generates the struct types of the argument, the result and the function argument
+// from their schemas, Validate methods that check the constraints of the schemas, and a method of Contracts:
+//
+//	contracts := bank.NewContracts(clientService)
+//
+//	result, executed, err := contracts.CreateAccount(bank.CreateAccountArgument{CustomerID: 1, CustomerName: "alice"})
+//
+// The contracts without the argument or the result schema take or return json.Object.
+// The cmd/scalardl-gen command runs the generator, e.g. with go:generate.
+package gen
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/scalar-labs/scalardl-go-client-sdk/v3/client/manifest"
+)
+
+// FunctionsKey is the key of the contract argument that lists the functions to execute with the contract.
+const FunctionsKey = "_functions_"
+
+// Options are the options of the generated code.
+type Options struct {
+	// Package is the package name of the generated code.
+	Package string
+
+	// Source is the name of the manifest file mentioned in the generated code.
+	Source string
+}
+
+// Generate generates the Go source code of the typed clients of the contracts in the manifest.
+// It fails if a schema can't be represented in Go, e.g. the argument is not an object,
+// or two contracts or properties result in the same Go name.
+func Generate(m manifest.Manifest, options Options) ([]byte, error) {
+	if options.Package == "" {
+		return nil, fmt.Errorf("the package name is required")
+	}
+
+	var g = &generator{names: reserved(), imports: map[string]bool{}}
+
+	for _, c := range m.Contracts {
+		if err := g.contract(c); err != nil {
+			return nil, fmt.Errorf("%s: %w", c.ID, err)
+		}
+	}
+
+	var source = options.Source
+	if source == "" {
+		source = "the manifest"
+	}
+
+	var out bytes.Buffer
+
+	fmt.Fprintf(&out, "// Code generated by scalardl-gen from %s. DO NOT EDIT.\n\n", source)
+	fmt.Fprintf(&out, "package %s\n\n", options.Package)
+
+	g.imports[`"github.com/scalar-labs/scalardl-go-client-sdk/v3/client/contract"`] = true
+	g.imports[`"github.com/scalar-labs/scalardl-go-client-sdk/v3/client/service"`] = true
+	g.imports[`"github.com/scalar-labs/scalardl-go-client-sdk/v3/ledger/model"`] = true
+
+	// the standard packages are followed by the others as goimports does.
+	var standard, others []string
+	for i := range g.imports {
+		if strings.Contains(i, ".") {
+			others = append(others, i)
+		} else {
+			standard = append(standard, i)
+		}
+	}
+	sort.Strings(standard)
+	sort.Strings(others)
+
+	var groups = []string{strings.Join(others, "\n")}
+	if len(standard) > 0 {
+		groups = append([]string{strings.Join(standard, "\n")}, groups...)
+	}
+
+	fmt.Fprintf(&out, "import (\n%s\n)\n\n", strings.Join(groups, "\n\n"))
+
+	fmt.Fprintf(&out, "// The IDs of the contracts.\nconst (\n%s)\n\n", g.constants.String())
+
+	fmt.Fprintf(&out, `// Contracts executes the contracts in %s with the typed arguments and results.
+type Contracts struct {
+	client  service.Client
+	options service.ContractExecutionOptions
+}
+
+// NewContracts creates Contracts that executes the contracts with the client.
+func NewContracts(client service.Client) Contracts {
+	return Contracts{client: client}
+}
+
+// WithOptions returns a copy of Contracts that executes the contracts with the options, e.g. the ordering keys.
+func (c Contracts) WithOptions(options service.ContractExecutionOptions) Contracts {
+	c.options = options
+	return c
+}
+
+`, source)
+
+	out.Write(g.methods.Bytes())
+
+	for _, t := range g.types {
+		out.Write(t)
+	}
+
+	formatted, err := format.Source(out.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("the generated code is malformed: %w", err)
+	}
+
+	return formatted, nil
+}
+
+// generator accumulates the declarations of the generated code.
+type generator struct {
+	constants bytes.Buffer
+	methods   bytes.Buffer
+
+	// types are the declarations of the types, a parent type followed by its nested ones.
+	types [][]byte
+
+	// names are the declared Go names and what they are declared for.
+	names   map[string]string
+	imports map[string]bool
+}
+
+// reserved returns the Go names declared by the fixed part of the generated code.
+// The methods of Contracts are qualified by the type, since they don't collide with the package-level names.
+func reserved() map[string]string {
+	return map[string]string{
+		"Contracts":             "the Contracts type",
+		"NewContracts":          "the NewContracts function",
+		"Contracts.WithOptions": "the WithOptions method",
+	}
+}
+
+// declare reserves the Go name.
+func (g *generator) declare(name string, what string) error {
+	if declared, ok := g.names[name]; ok {
+		return fmt.Errorf("%s and %s have the same Go name %s", declared, what, name)
+	}
+
+	g.names[name] = what
+
+	return nil
+}
+
+// contract generates the ID constant, the method and the types of the contract.
+func (g *generator) contract(c manifest.Contract) (err error) {
+	var (
+		name = goName(c.ID)
+		id   = name + "ID"
+	)
+
+	if err = g.declare(id, "the ID of "+c.ID); err != nil {
+		return
+	}
+
+	if err = g.declare("Contracts."+name, "the method of "+c.ID); err != nil {
+		return
+	}
+
+	fmt.Fprintf(&g.constants, "%s = %s\n", id, strconv.Quote(c.ID))
+
+	argument, validated, err := g.object(name+"Argument", "the argument of the "+c.ID+" contract", c.Argument, true)
+	if err != nil {
+		return fmt.Errorf("argument: %w", err)
+	}
+
+	result, _, err := g.object(name+"Result", "the result of the "+c.ID+" contract", c.Result, false)
+	if err != nil {
+		return fmt.Errorf("result: %w", err)
+	}
+
+	var (
+		w          = &g.methods
+		parameters = "argument " + argument
+	)
+
+	fmt.Fprintf(w, "// %s executes the %s contract.\n", name, c.ID)
+
+	if c.Function == "" {
+		fmt.Fprintf(w, "func (c Contracts) %s(%s) (result %s, executed model.ContractExecutionResult, err error) {\n", name, parameters, result)
+
+		if validated {
+			g.validateParameter(w, "argument", id)
+		}
+
+		fmt.Fprintf(w, "executed, err = contract.ExecuteWithOptions(c.client, %s, argument, nil, c.options, &result)\n\nreturn\n}\n\n", id)
+
+		return
+	}
+
+	functionArgument, functionValidated, err := g.object(
+		name+"FunctionArgument", "the argument of the "+c.Function+" function executed with the "+c.ID+" contract", c.FunctionArgument, true,
+	)
+	if err != nil {
+		return fmt.Errorf("function argument: %w", err)
+	}
+
+	g.imports[`"fmt"`] = true
+	g.imports[`"github.com/scalar-labs/scalardl-go-client-sdk/v3/json"`] = true
+
+	parameters += ", functionArgument " + functionArgument
+
+	fmt.Fprintf(w, "// The contract executes the %s function with the function argument.\n", c.Function)
+	fmt.Fprintf(w, "func (c Contracts) %s(%s) (result %s, executed model.ContractExecutionResult, err error) {\n", name, parameters, result)
+
+	if validated {
+		g.validateParameter(w, "argument", id)
+	}
+
+	if functionValidated {
+		g.validateParameter(w, "function argument", id)
+	}
+
+	fmt.Fprintf(w, `var o json.Object
+if o, err = contract.ToObject(argument); err != nil {
+	err = fmt.Errorf("the argument of %%s: %%w", %s, err)
+	return
+}
+
+var a = json.Object{}
+for k, v := range o {
+	a[k] = v
+}
+a[%s] = []string{%s}
+
+executed, err = contract.ExecuteWithOptions(c.client, %s, a, functionArgument, c.options, &result)
+
+return
+}
+
+`, id, strconv.Quote(FunctionsKey), strconv.Quote(c.Function), id)
+
+	return
+}
+
+// validateParameter writes the validation of the parameter of a method.
+func (g *generator) validateParameter(w *bytes.Buffer, what string, id string) {
+	g.imports[`"fmt"`] = true
+
+	var parameter = "argument"
+	if what != "argument" {
+		parameter = "functionArgument"
+	}
+
+	fmt.Fprintf(w, `if err = %s.Validate(); err != nil {
+	err = fmt.Errorf("the %s of %%s: %%w", %s, err)
+	return
+}
+
+`, parameter, what, id)
+}
+
+// object returns the Go type of an argument or a result, which must be an object.
+// A nil schema or an object without properties is json.Object.
+// It also returns if the type has Validate.
+func (g *generator) object(name string, doc string, s *manifest.Schema, validated bool) (string, bool, error) {
+	if s == nil || (s.Type == manifest.TypeObject && len(s.Properties) == 0) {
+		g.imports[`"github.com/scalar-labs/scalardl-go-client-sdk/v3/json"`] = true
+		return "json.Object", false, nil
+	}
+
+	if s.Type != manifest.TypeObject {
+		return "", false, fmt.Errorf("must be an object rather than %q", s.Type)
+	}
+
+	if err := g.structure(name, doc, *s, validated); err != nil {
+		return "", false, err
+	}
+
+	return name, validated, nil
+}
+
+// goType returns the Go type of the schema, declaring the struct types of the objects with properties.
+func (g *generator) goType(name string, doc string, s manifest.Schema, validated bool) (string, error) {
+	switch s.Type {
+	case manifest.TypeString:
+		return "string", nil
+	case manifest.TypeInteger:
+		return "int64", nil
+	case manifest.TypeNumber:
+		return "float64", nil
+	case manifest.TypeBoolean:
+		return "bool", nil
+	case manifest.TypeArray:
+		var items manifest.Schema
+		if s.Items != nil {
+			items = *s.Items
+		}
+
+		t, err := g.goType(name+"Item", "an item of "+doc, items, validated)
+		if err != nil {
+			return "", err
+		}
+
+		return "[]" + t, nil
+	case manifest.TypeObject:
+		if len(s.Properties) == 0 {
+			g.imports[`"github.com/scalar-labs/scalardl-go-client-sdk/v3/json"`] = true
+			return "json.Object", nil
+		}
+
+		return name, g.structure(name, doc, s, validated)
+	default:
+		return "interface{}", nil
+	}
+}
+
+// structure declares the struct type of the object, and its Validate if validated.
+func (g *generator) structure(name string, doc string, s manifest.Schema, validated bool) error {
+	if err := g.declare(name, doc); err != nil {
+		return err
+	}
+
+	// the slot of the declaration is reserved to precede the nested types declared while the fields are generated.
+	var slot = len(g.types)
+	g.types = append(g.types, nil)
+
+	var (
+		properties = sortedProperties(s)
+		fields     = map[string]string{}
+		body       bytes.Buffer
+		validation bytes.Buffer
+	)
+
+	for _, property := range properties {
+		var (
+			p     = s.Properties[property]
+			field = goName(property)
+		)
+
+		if other, ok := fields[field]; ok {
+			return fmt.Errorf("the properties %s and %s have the same Go name %s", other, property, field)
+		}
+
+		fields[field] = property
+
+		t, err := g.goType(name+field, "the "+property+" property of "+doc, p, validated)
+		if err != nil {
+			return fmt.Errorf("%s: %w", property, err)
+		}
+
+		var (
+			required = s.IsRequired(property)
+			tag      = property
+		)
+
+		if !required {
+			tag += ",omitempty"
+
+			if isPointable(p) {
+				t = "*" + t
+			}
+		}
+
+		if p.Description != "" {
+			fmt.Fprintf(&body, "// %s\n", strings.ReplaceAll(strings.TrimSpace(p.Description), "\n", "\n// "))
+		}
+
+		fmt.Fprintf(&body, "%s %s `json:%s`\n", field, t, strconv.Quote(tag))
+
+		if validated {
+			var value = "a." + field
+
+			if !required && isPointable(p) {
+				var checks bytes.Buffer
+
+				if err = g.checks(&checks, "*"+value, value, p, path{format: escape(property)}, 0); err != nil {
+					return fmt.Errorf("%s: %w", property, err)
+				}
+
+				if checks.Len() > 0 {
+					fmt.Fprintf(&validation, "if %s != nil {\n%s}\n\n", value, block(checks))
+				}
+			} else if err = g.checks(&validation, value, value, p, path{format: escape(property)}, 0); err != nil {
+				return fmt.Errorf("%s: %w", property, err)
+			}
+		}
+	}
+
+	var declaration bytes.Buffer
+
+	fmt.Fprintf(&declaration, "// %s is %s.\n", name, doc)
+
+	if s.Description != "" {
+		fmt.Fprintf(&declaration, "//\n// %s\n", strings.ReplaceAll(strings.TrimSpace(s.Description), "\n", "\n// "))
+	}
+
+	fmt.Fprintf(&declaration, "type %s struct {\n%s}\n\n", name, body.String())
+
+	if validated {
+		fmt.Fprintf(&declaration, "// Validate checks the constraints of the schema of %s.\n", name)
+		fmt.Fprintf(&declaration, "func (a %s) Validate() error {\n%sreturn nil\n}\n\n", name, validation.String())
+	}
+
+	g.types[slot] = declaration.Bytes()
+
+	return nil
+}
+
+// path is the format of the path of a value in the error messages, with the index variables of the arrays.
+type path struct {
+	format  string
+	indexes []string
+}
+
+// args returns the arguments of fmt.Errorf for the path followed by the others.
+func (p path) args(others ...string) string {
+	var args = append(append([]string{}, p.indexes...), others...)
+	if len(args) == 0 {
+		return ""
+	}
+
+	return ", " + strings.Join(args, ", ")
+}
+
+// checks writes the checks of the value against the constraints of the schema.
+// The value is the expression of the value, and object is the expression to call Validate on.
+func (g *generator) checks(w *bytes.Buffer, value string, object string, s manifest.Schema, p path, depth int) error {
+	var fail = func(condition string, message string) {
+		g.imports[`"fmt"`] = true
+		fmt.Fprintf(w, "if %s {\nreturn fmt.Errorf(%s%s)\n}\n\n", condition, strconv.Quote(p.format+" "+escape(message)), p.args())
+	}
+
+	if len(s.Enum) > 0 {
+		var (
+			conditions = make([]string, 0, len(s.Enum))
+			literals   = make([]string, 0, len(s.Enum))
+		)
+
+		for _, e := range s.Enum {
+			l, err := literal(s.Type, e)
+			if err != nil {
+				return err
+			}
+
+			conditions = append(conditions, value+" != "+l)
+			literals = append(literals, l)
+		}
+
+		fail(strings.Join(conditions, " && "), "must be one of "+strings.Join(literals, ", "))
+	}
+
+	switch s.Type {
+	case manifest.TypeString:
+		if s.MinLength != nil || s.MaxLength != nil {
+			g.imports[`"unicode/utf8"`] = true
+		}
+
+		if s.MinLength != nil {
+			fail(fmt.Sprintf("utf8.RuneCountInString(%s) < %d", value, *s.MinLength), "must have at least "+characters(*s.MinLength))
+		}
+
+		if s.MaxLength != nil {
+			fail(fmt.Sprintf("utf8.RuneCountInString(%s) > %d", value, *s.MaxLength), "must have at most "+characters(*s.MaxLength))
+		}
+	case manifest.TypeInteger, manifest.TypeNumber:
+		if s.Minimum != nil {
+			var minimum = *s.Minimum
+			if s.Type == manifest.TypeInteger {
+				minimum = math.Ceil(minimum)
+			}
+
+			fail(value+" < "+number(minimum), "must be at least "+number(minimum))
+		}
+
+		if s.Maximum != nil {
+			var maximum = *s.Maximum
+			if s.Type == manifest.TypeInteger {
+				maximum = math.Floor(maximum)
+			}
+
+			fail(value+" > "+number(maximum), "must be at most "+number(maximum))
+		}
+	case manifest.TypeObject:
+		if len(s.Properties) > 0 {
+			g.imports[`"fmt"`] = true
+			fmt.Fprintf(w, "if err := %s.Validate(); err != nil {\nreturn fmt.Errorf(%s%s)\n}\n\n", object, strconv.Quote(p.format+".%w"), p.args("err"))
+		}
+	case manifest.TypeArray:
+		if s.Items == nil {
+			break
+		}
+
+		var (
+			index = fmt.Sprintf("i%d", depth)
+			item  = fmt.Sprintf("e%d", depth)
+			inner bytes.Buffer
+		)
+
+		var itemPath = path{format: p.format + "[%d]", indexes: append(append([]string{}, p.indexes...), index)}
+
+		if err := g.checks(&inner, item, item, *s.Items, itemPath, depth+1); err != nil {
+			return err
+		}
+
+		if inner.Len() > 0 {
+			fmt.Fprintf(w, "for %s, %s := range %s {\n%s}\n\n", index, item, value, block(inner))
+		}
+	}
+
+	return nil
+}
+
+// block returns the statements in a block without the trailing blank line.
+func block(statements bytes.Buffer) string {
+	return strings.TrimRight(statements.String(), "\n") + "\n"
+}
+
+// characters formats the number of characters in the error messages.
+func characters(n int) string {
+	if n == 1 {
+		return "1 character"
+	}
+
+	return strconv.Itoa(n) + " characters"
+}
+
+// isPointable checks if the optional property of the schema is a pointer, to tell the absence from the zero value.
+// The arrays, the objects without properties and any values are nil when absent.
+func isPointable(s manifest.Schema) bool {
+	switch s.Type {
+	case manifest.TypeString, manifest.TypeInteger, manifest.TypeNumber, manifest.TypeBoolean:
+		return true
+	case manifest.TypeObject:
+		return len(s.Properties) > 0
+	default:
+		return false
+	}
+}
+
+// literal returns the Go literal of the enum value of the type.
+func literal(t string, v interface{}) (string, error) {
+	switch value := v.(type) {
+	case string:
+		if t == manifest.TypeString {
+			return strconv.Quote(value), nil
+		}
+	case float64:
+		if t == manifest.TypeNumber || (t == manifest.TypeInteger && value == math.Trunc(value)) {
+			return number(value), nil
+		}
+	case bool:
+		if t == manifest.TypeBoolean {
+			return strconv.FormatBool(value), nil
+		}
+	}
+
+	return "", fmt.Errorf("the enum value %v is not %s", v, t)
+}
+
+// number formats the number as a Go constant.
+func number(f float64) string {
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
+
+// escape escapes the verbs of fmt in the text.
+func escape(s string) string {
+	return strings.ReplaceAll(s, "%", "%%")
+}
+
+// sortedProperties returns the property names of the object in order.
+func sortedProperties(s manifest.Schema) []string {
+	var properties = make([]string, 0, len(s.Properties))
+	for p := range s.Properties {
+		properties = append(properties, p)
+	}
+	sort.Strings(properties)
+
+	return properties
+}
+
+// initialisms are the words in upper case in the Go names.
+var initialisms = map[string]bool{
+	"api": true, "http": true, "id": true, "ip": true, "json": true, "uri": true, "url": true, "uuid": true,
+}
+
+// goName converts an ID or a property name, e.g. create_account or customerId, to an exported Go name.
+func goName(s string) string {
+	var (
+		words = strings.FieldsFunc(s, func(r rune) bool { return !unicode.IsLetter(r) && !unicode.IsDigit(r) })
+		name  strings.Builder
+	)
+
+	for _, word := range splitCamelCase(words) {
+		if initialisms[strings.ToLower(word)] {
+			name.WriteString(strings.ToUpper(word))
+			continue
+		}
+
+		var runes = []rune(word)
+		runes[0] = unicode.ToUpper(runes[0])
+		name.WriteString(string(runes))
+	}
+
+	var n = name.String()
+	if n == "" || !unicode.IsLetter([]rune(n)[0]) {
+		n = "X" + n
+	}
+
+	return n
+}
+
+// splitCamelCase splits the words at the lower-to-upper case boundaries, e.g. customerId to customer and Id.
+func splitCamelCase(words []string) []string {
+	var split []string
+
+	for _, word := range words {
+		var (
+			runes = []rune(word)
+			start = 0
+		)
+
+		for i := 1; i < len(runes); i++ {
+			if unicode.IsUpper(runes[i]) && unicode.IsLower(runes[i-1]) {
+				split = append(split, string(runes[start:i]))
+				start = i
+			}
+		}
+
+		split = append(split, string(runes[start:]))
+	}
+
+	return split
+}
@@ -0,0 +1,83 @@
+package gen
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/scalar-labs/scalardl-go-client-sdk/v3/client/manifest"
+)
+
+func TestGenerate(t *testing.T) {
+	m, err := manifest.NewManifestFromFile("testdata/contracts.yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	code, err := Generate(m, Options{Package: "testcontracts", Source: "contracts.yaml"})
+	if err != nil {
+		t.Fatalf("should generate the code: %v", err)
+	}
+
+	generated, err := os.ReadFile("internal/testcontracts/contracts.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// internal/testcontracts is compiled and tested with the generated code.
+	if string(code) != string(generated) {
+		t.Errorf("should generate internal/testcontracts/contracts.go, which needs go generate:\n%s", code)
+	}
+
+	if _, err = Generate(m, Options{}); err == nil {
+		t.Errorf("should require the package name")
+	}
+}
+
+func TestGenerate_Invalid(t *testing.T) {
+	for _, tc := range []struct {
+		manifest string
+		expected string
+	}{
+		{`{"contracts":[{"id":"a","class_file":"A.class","argument":{"type":"array"}}]}`, "must be an object"},
+		{`{"contracts":[{"id":"a-b","class_file":"A.class"},{"id":"a_b","class_file":"B.class"}]}`, "the same Go name ABID"},
+		{`{"contracts":[{"id":"with_options","class_file":"A.class"}]}`, "the same Go name Contracts.WithOptions"},
+		{
+			`{"contracts":[{"id":"a","class_file":"A.class","argument":{"type":"object","properties":{"x_y":{},"xY":{}}}}]}`,
+			"the same Go name XY",
+		},
+		{
+			`{"contracts":[{"id":"a","class_file":"A.class","argument":{"type":"object","properties":{"n":{"type":"integer","enum":[1.5]}}}}]}`,
+			"the enum value 1.5 is not integer",
+		},
+		{
+			`{"contracts":[{"id":"a","class_file":"A.class","argument":{"type":"object","properties":{"s":{"type":"string","enum":[1]}}}}]}`,
+			"the enum value 1 is not string",
+		},
+	} {
+		m, err := manifest.NewManifestFromJSON(tc.manifest)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if _, err = Generate(m, Options{Package: "p"}); err == nil || !strings.Contains(err.Error(), tc.expected) {
+			t.Errorf("should fail with %q for %s: %v", tc.expected, tc.manifest, err)
+		}
+	}
+}
+
+func TestGoName(t *testing.T) {
+	for s, expected := range map[string]string{
+		"create_account":     "CreateAccount",
+		"state-updater":      "StateUpdater",
+		"customerId":         "CustomerID",
+		"com.example.Foo":    "ComExampleFoo",
+		"api_url":            "APIURL",
+		"2fa":                "X2fa",
+		"already_CamelCased": "AlreadyCamelCased",
+	} {
+		if name := goName(s); name != expected {
+			t.Errorf("should convert %s to %s rather than %s", s, expected, name)
+		}
+	}
+}
@@ -0,0 +1,224 @@
+// Code generated by scalardl-gen from contracts.yaml. DO NOT EDIT.
+
+package testcontracts
+
+import (
+	"fmt"
+	"unicode/utf8"
+
+	"github.com/scalar-labs/scalardl-go-client-sdk/v3/client/contract"
+	"github.com/scalar-labs/scalardl-go-client-sdk/v3/client/service"
+	"github.com/scalar-labs/scalardl-go-client-sdk/v3/json"
+	"github.com/scalar-labs/scalardl-go-client-sdk/v3/ledger/model"
+)
+
+// The IDs of the contracts.
+const (
+	CreateAccountID = "create_account"
+	TransferID      = "transfer"
+	GetBalanceID    = "get-balance"
+)
+
+// Contracts executes the contracts in contracts.yaml with the typed arguments and results.
+type Contracts struct {
+	client  service.Client
+	options service.ContractExecutionOptions
+}
+
+// NewContracts creates Contracts that executes the contracts with the client.
+func NewContracts(client service.Client) Contracts {
+	return Contracts{client: client}
+}
+
+// WithOptions returns a copy of Contracts that executes the contracts with the options, e.g. the ordering keys.
+func (c Contracts) WithOptions(options service.ContractExecutionOptions) Contracts {
+	c.options = options
+	return c
+}
+
+// CreateAccount executes the create_account contract.
+func (c Contracts) CreateAccount(argument CreateAccountArgument) (result CreateAccountResult, executed model.ContractExecutionResult, err error) {
+	if err = argument.Validate(); err != nil {
+		err = fmt.Errorf("the argument of %s: %w", CreateAccountID, err)
+		return
+	}
+
+	executed, err = contract.ExecuteWithOptions(c.client, CreateAccountID, argument, nil, c.options, &result)
+
+	return
+}
+
+// Transfer executes the transfer contract.
+// The contract executes the audit-log function with the function argument.
+func (c Contracts) Transfer(argument TransferArgument, functionArgument TransferFunctionArgument) (result json.Object, executed model.ContractExecutionResult, err error) {
+	if err = argument.Validate(); err != nil {
+		err = fmt.Errorf("the argument of %s: %w", TransferID, err)
+		return
+	}
+
+	if err = functionArgument.Validate(); err != nil {
+		err = fmt.Errorf("the function argument of %s: %w", TransferID, err)
+		return
+	}
+
+	var o json.Object
+	if o, err = contract.ToObject(argument); err != nil {
+		err = fmt.Errorf("the argument of %s: %w", TransferID, err)
+		return
+	}
+
+	var a = json.Object{}
+	for k, v := range o {
+		a[k] = v
+	}
+	a["_functions_"] = []string{"audit-log"}
+
+	executed, err = contract.ExecuteWithOptions(c.client, TransferID, a, functionArgument, c.options, &result)
+
+	return
+}
+
+// GetBalance executes the get-balance contract.
+func (c Contracts) GetBalance(argument json.Object) (result json.Object, executed model.ContractExecutionResult, err error) {
+	executed, err = contract.ExecuteWithOptions(c.client, GetBalanceID, argument, nil, c.options, &result)
+
+	return
+}
+
+// CreateAccountArgument is the argument of the create_account contract.
+type CreateAccountArgument struct {
+	Address *CreateAccountArgumentAddress `json:"address,omitempty"`
+	// The ID of the customer.
+	CustomerID     int64    `json:"customer_id"`
+	CustomerName   string   `json:"customer_name"`
+	InitialBalance *float64 `json:"initial_balance,omitempty"`
+	Kind           *string  `json:"kind,omitempty"`
+	Tags           []string `json:"tags,omitempty"`
+}
+
+// Validate checks the constraints of the schema of CreateAccountArgument.
+func (a CreateAccountArgument) Validate() error {
+	if a.Address != nil {
+		if err := a.Address.Validate(); err != nil {
+			return fmt.Errorf("address.%w", err)
+		}
+	}
+
+	if a.CustomerID < 0 {
+		return fmt.Errorf("customer_id must be at least 0")
+	}
+
+	if utf8.RuneCountInString(a.CustomerName) < 1 {
+		return fmt.Errorf("customer_name must have at least 1 character")
+	}
+
+	if utf8.RuneCountInString(a.CustomerName) > 16 {
+		return fmt.Errorf("customer_name must have at most 16 characters")
+	}
+
+	if a.InitialBalance != nil {
+		if *a.InitialBalance < 0 {
+			return fmt.Errorf("initial_balance must be at least 0")
+		}
+
+		if *a.InitialBalance > 1000000 {
+			return fmt.Errorf("initial_balance must be at most 1000000")
+		}
+	}
+
+	if a.Kind != nil {
+		if *a.Kind != "checking" && *a.Kind != "savings" {
+			return fmt.Errorf("kind must be one of \"checking\", \"savings\"")
+		}
+	}
+
+	for i0, e0 := range a.Tags {
+		if utf8.RuneCountInString(e0) > 8 {
+			return fmt.Errorf("tags[%d] must have at most 8 characters", i0)
+		}
+	}
+
+	return nil
+}
+
+// CreateAccountArgumentAddress is the address property of the argument of the create_account contract.
+type CreateAccountArgumentAddress struct {
+	City string  `json:"city"`
+	Zip  *string `json:"zip,omitempty"`
+}
+
+// Validate checks the constraints of the schema of CreateAccountArgumentAddress.
+func (a CreateAccountArgumentAddress) Validate() error {
+	if utf8.RuneCountInString(a.City) < 1 {
+		return fmt.Errorf("city must have at least 1 character")
+	}
+
+	return nil
+}
+
+// CreateAccountResult is the result of the create_account contract.
+type CreateAccountResult struct {
+	Balance    float64 `json:"balance"`
+	CustomerID int64   `json:"customer_id"`
+	Kind       *string `json:"kind,omitempty"`
+}
+
+// TransferArgument is the argument of the transfer contract.
+type TransferArgument struct {
+	Amount int64  `json:"amount"`
+	From   string `json:"from"`
+	To     string `json:"to"`
+}
+
+// Validate checks the constraints of the schema of TransferArgument.
+func (a TransferArgument) Validate() error {
+	if a.Amount < 1 {
+		return fmt.Errorf("amount must be at least 1")
+	}
+
+	return nil
+}
+
+// TransferFunctionArgument is the argument of the audit-log function executed with the transfer contract.
+type TransferFunctionArgument struct {
+	Approvers []TransferFunctionArgumentApproversItem `json:"approvers,omitempty"`
+	Note      *string                                 `json:"note,omitempty"`
+}
+
+// Validate checks the constraints of the schema of TransferFunctionArgument.
+func (a TransferFunctionArgument) Validate() error {
+	for i0, e0 := range a.Approvers {
+		if err := e0.Validate(); err != nil {
+			return fmt.Errorf("approvers[%d].%w", i0, err)
+		}
+	}
+
+	if a.Note != nil {
+		if utf8.RuneCountInString(*a.Note) > 140 {
+			return fmt.Errorf("note must have at most 140 characters")
+		}
+	}
+
+	return nil
+}
+
+// TransferFunctionArgumentApproversItem is an item of the approvers property of the argument of the audit-log function executed with the transfer contract.
+type TransferFunctionArgumentApproversItem struct {
+	Level *int64 `json:"level,omitempty"`
+	Name  string `json:"name"`
+}
+
+// Validate checks the constraints of the schema of TransferFunctionArgumentApproversItem.
+func (a TransferFunctionArgumentApproversItem) Validate() error {
+	if a.Level != nil {
+		if *a.Level != 1 && *a.Level != 2 && *a.Level != 3 {
+			return fmt.Errorf("level must be one of 1, 2, 3")
+		}
+	}
+
+	if utf8.RuneCountInString(a.Name) < 1 {
+		return fmt.Errorf("name must have at least 1 character")
+	}
+
+	return nil
+}
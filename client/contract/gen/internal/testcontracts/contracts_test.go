@@ -0,0 +1,93 @@
+package testcontracts
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/scalar-labs/scalardl-go-client-sdk/v3/client/service"
+	"github.com/scalar-labs/scalardl-go-client-sdk/v3/client/service/mock"
+	"github.com/scalar-labs/scalardl-go-client-sdk/v3/json"
+	"github.com/scalar-labs/scalardl-go-client-sdk/v3/ledger/model"
+)
+
+func TestContracts(t *testing.T) {
+	var (
+		client    = mock.NewClient(t)
+		contracts = NewContracts(client)
+		checking  = "checking"
+		options   = service.ContractExecutionOptions{OrderingKeys: []string{"alice"}}
+	)
+
+	client.On("ExecuteContractWithOptions", CreateAccountID, json.Object{"customer_id": 1, "customer_name": "alice", "kind": "checking"}, nil, mock.Any).
+		Return(model.ContractExecutionResult{Result: json.Object{"customer_id": 1.0, "balance": 100.0, "kind": "checking"}})
+	client.On("ExecuteContractWithOptions", TransferID,
+		json.Object{"from": "alice", "to": "bob", "amount": 10, "_functions_": []string{"audit-log"}},
+		json.Object{"approvers": []interface{}{map[string]interface{}{"name": "carol"}}},
+		options,
+	).Return(model.ContractExecutionResult{Result: json.Object{"status": "ok"}})
+	client.On("ExecuteContractWithOptions", GetBalanceID, json.Object{"customer_id": 1}, nil, mock.Any).
+		Return(model.ContractExecutionResult{Result: json.Object{"balance": 100.0}})
+
+	result, executed, err := contracts.CreateAccount(CreateAccountArgument{CustomerID: 1, CustomerName: "alice", Kind: &checking})
+	if err != nil || result.CustomerID != 1 || result.Balance != 100 || result.Kind == nil || *result.Kind != "checking" || executed.Result == nil {
+		t.Errorf("should execute the contract and decode the result: %v %v", result, err)
+	}
+
+	r, _, err := contracts.WithOptions(options).Transfer(
+		TransferArgument{From: "alice", To: "bob", Amount: 10},
+		TransferFunctionArgument{Approvers: []TransferFunctionArgumentApproversItem{{Name: "carol"}}},
+	)
+	if err != nil || r["status"] != "ok" {
+		t.Errorf("should execute the contract with the function: %v %v", r, err)
+	}
+
+	if r, _, err = contracts.GetBalance(json.Object{"customer_id": 1}); err != nil || r["balance"] != 100.0 {
+		t.Errorf("should execute the contract without the schemas: %v %v", r, err)
+	}
+
+	client.Verify()
+}
+
+func TestContracts_Validation(t *testing.T) {
+	var (
+		client    = mock.NewClient(t)
+		contracts = NewContracts(client)
+		kind      = "credit"
+		level     = int64(4)
+		zero      = 0.0
+		negative  = -1.0
+	)
+
+	for _, tc := range []struct {
+		argument CreateAccountArgument
+		expected string
+	}{
+		{CreateAccountArgument{CustomerID: -1, CustomerName: "alice"}, "customer_id must be at least 0"},
+		{CreateAccountArgument{CustomerName: ""}, "customer_name must have at least 1 character"},
+		{CreateAccountArgument{CustomerName: strings.Repeat("あ", 17)}, "customer_name must have at most 16 characters"},
+		{CreateAccountArgument{CustomerName: "alice", Kind: &kind}, `kind must be one of "checking", "savings"`},
+		{CreateAccountArgument{CustomerName: "alice", InitialBalance: &negative}, "initial_balance must be at least 0"},
+		{CreateAccountArgument{CustomerName: "alice", Address: &CreateAccountArgumentAddress{}}, "address.city must have at least 1 character"},
+		{CreateAccountArgument{CustomerName: "alice", Tags: []string{"ok", "too long tag"}}, "tags[1] must have at most 8 characters"},
+	} {
+		if _, _, err := contracts.CreateAccount(tc.argument); err == nil || err.Error() != "the argument of create_account: "+tc.expected {
+			t.Errorf("should fail with %q: %v", tc.expected, err)
+		}
+	}
+
+	if err := (CreateAccountArgument{CustomerName: strings.Repeat("あ", 16), InitialBalance: &zero}).Validate(); err != nil {
+		t.Errorf("should accept the valid argument: %v", err)
+	}
+
+	_, _, err := contracts.Transfer(
+		TransferArgument{From: "alice", To: "bob", Amount: 1},
+		TransferFunctionArgument{Approvers: []TransferFunctionArgumentApproversItem{{Name: "carol"}, {Name: "dave", Level: &level}}},
+	)
+	if err == nil || err.Error() != "the function argument of transfer: approvers[1].level must be one of 1, 2, 3" {
+		t.Errorf("should validate the function argument: %v", err)
+	}
+
+	if len(client.Calls()) != 0 {
+		t.Errorf("should not execute the contracts with the invalid arguments: %v", client.Calls())
+	}
+}
@@ -0,0 +1,4 @@
+// Package testcontracts is the code generated from testdata/contracts.yaml, which the tests of the generator use.
+package testcontracts
+
+//go:generate go run ../../../../../cmd/scalardl-gen --manifest ../../testdata/contracts.yaml --package testcontracts --output contracts.go
@@ -0,0 +1,175 @@
+package manifest
+
+import (
+	ej "encoding/json"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/scalar-labs/scalardl-go-client-sdk/v3/json"
+	"gopkg.in/yaml.v2"
+)
+
+// Manifest declares the certificate, the contracts and the functions to deploy to Scalar DL networks.
+// We can use NewManifestFromYAML, NewManifestFromJSON or NewManifestFromFile to create it.
+//
+// The example in YAML:
+//	register_certificate: true
+//	contracts:
+//	  - id: state-updater
+//	    class_file: StateUpdater.class
+//	    properties:
+//	      owner: org1
+//	  - id: state-reader
+//	    jar_file: contracts.jar
+//	    binary_name: com.org1.contract.StateReader
+//	functions:
+//	  - id: state-function
+//	    class_file: StateFunction.class
+type Manifest struct {
+	RegisterCertificate bool       `json:"register_certificate"`
+	Contracts           []Contract `json:"contracts" validate:"dive"`
+	Functions           []Function `json:"functions" validate:"dive"`
+}
+
+// Contract declares a contract to register.
+// Either ClassFile or JarFile is required. BinaryName is derived from the class file if omitted,
+// but it is required to choose a class in JarFile.
+//
+// Argument, Result, Function and FunctionArgument describe how the contract is executed,
+// which scalardl-gen uses to generate the typed Go client. They don't affect the deployment.
+type Contract struct {
+	ID               string      `json:"id" validate:"required"`
+	BinaryName       string      `json:"binary_name" validate:"required_with=JarFile"`
+	ClassFile        string      `json:"class_file" validate:"required_without=JarFile,excluded_with=JarFile"`
+	JarFile          string      `json:"jar_file" validate:"required_without=ClassFile"`
+	Properties       json.Object `json:"properties"`
+	Argument         *Schema     `json:"argument"`
+	Result           *Schema     `json:"result"`
+	Function         string      `json:"function"`
+	FunctionArgument *Schema     `json:"function_argument"`
+}
+
+// Function declares a function to register.
+// Either ClassFile or JarFile is required. BinaryName is derived from the class file if omitted,
+// but it is required to choose a class in JarFile.
+type Function struct {
+	ID         string `json:"id" validate:"required"`
+	BinaryName string `json:"binary_name" validate:"required_with=JarFile"`
+	ClassFile  string `json:"class_file" validate:"required_without=JarFile,excluded_with=JarFile"`
+	JarFile    string `json:"jar_file" validate:"required_without=ClassFile"`
+}
+
+var validate *validator.Validate = validator.New()
+
+// Validate checks if mandatory fields are assign and well-formatted.
+func (m *Manifest) Validate() error {
+	if err := validate.Struct(m); err != nil {
+		return err
+	}
+
+	for _, c := range m.Contracts {
+		if c.FunctionArgument != nil && c.Function == "" {
+			return fmt.Errorf("the function argument of %s requires the function", c.ID)
+		}
+	}
+
+	return nil
+}
+
+// NewManifestFromJSON parses the given JSON string to create Manifest.
+func NewManifestFromJSON(s string) (m Manifest, err error) {
+	if err = ej.Unmarshal([]byte(s), &m); err != nil {
+		return
+	}
+
+	err = m.Validate()
+
+	return
+}
+
+// NewManifestFromYAML parses the given YAML string to create Manifest.
+func NewManifestFromYAML(s string) (m Manifest, err error) {
+	var (
+		parsed interface{}
+		j      []byte
+	)
+
+	if err = yaml.Unmarshal([]byte(s), &parsed); err != nil {
+		return
+	}
+
+	// YAML is converted to JSON to share the same structure tags,
+	// and to make nested properties json.Object rather than map[interface{}]interface{}.
+	if j, err = ej.Marshal(toJSONCompatible(parsed)); err != nil {
+		return
+	}
+
+	return NewManifestFromJSON(string(j))
+}
+
+// NewManifestFromFile reads the manifest file at the given path.
+// The format is detected by the extension: .json for JSON, and .yaml or .yml for YAML.
+// Relative class file and jar file paths in the manifest are resolved against the directory of the manifest file.
+func NewManifestFromFile(path string) (m Manifest, err error) {
+	var b []byte
+
+	if b, err = ioutil.ReadFile(path); err != nil {
+		return
+	}
+
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".json":
+		m, err = NewManifestFromJSON(string(b))
+	case ".yaml", ".yml":
+		m, err = NewManifestFromYAML(string(b))
+	default:
+		err = fmt.Errorf("unsupported manifest file extension: %s", filepath.Ext(path))
+	}
+
+	if err != nil {
+		return
+	}
+
+	var dir = filepath.Dir(path)
+
+	for i := range m.Contracts {
+		m.Contracts[i].ClassFile = resolve(dir, m.Contracts[i].ClassFile)
+		m.Contracts[i].JarFile = resolve(dir, m.Contracts[i].JarFile)
+	}
+
+	for i := range m.Functions {
+		m.Functions[i].ClassFile = resolve(dir, m.Functions[i].ClassFile)
+		m.Functions[i].JarFile = resolve(dir, m.Functions[i].JarFile)
+	}
+
+	return
+}
+
+func resolve(dir string, path string) string {
+	if path == "" || filepath.IsAbs(path) {
+		return path
+	}
+
+	return filepath.Join(dir, path)
+}
+
+func toJSONCompatible(v interface{}) interface{} {
+	switch value := v.(type) {
+	case map[interface{}]interface{}:
+		var m = make(map[string]interface{}, len(value))
+		for k, e := range value {
+			m[fmt.Sprint(k)] = toJSONCompatible(e)
+		}
+		return m
+	case []interface{}:
+		for i, e := range value {
+			value[i] = toJSONCompatible(e)
+		}
+		return value
+	default:
+		return value
+	}
+}
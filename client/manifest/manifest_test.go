@@ -0,0 +1,170 @@
+package manifest
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/scalar-labs/scalardl-go-client-sdk/v3/json"
+)
+
+func TestNewManifestFromYAML(t *testing.T) {
+	var yaml = `
+register_certificate: true
+contracts:
+  - id: state-updater
+    class_file: StateUpdater.class
+    properties:
+      owner: org1
+      nested:
+        depth: 2
+  - id: state-reader
+    jar_file: contracts.jar
+    binary_name: com.org1.contract.StateReader
+functions:
+  - id: state-function
+    class_file: StateFunction.class
+`
+
+	m, err := NewManifestFromYAML(yaml)
+
+	if err != nil {
+		t.Fatalf("can't load YAML: %v", err)
+	}
+
+	if !m.RegisterCertificate {
+		t.Errorf("RegisterCertificate is not match")
+	}
+
+	if len(m.Contracts) != 2 || len(m.Functions) != 1 {
+		t.Fatalf("the numbers of contracts and functions are not match")
+	}
+
+	if m.Contracts[0].ID != "state-updater" || m.Contracts[0].ClassFile != "StateUpdater.class" {
+		t.Errorf("the first contract is not match")
+	}
+
+	if !m.Contracts[0].Properties.Equal(json.Object{"owner": "org1", "nested": map[string]interface{}{"depth": float64(2)}}) {
+		t.Errorf("Properties is not match: %v", m.Contracts[0].Properties)
+	}
+
+	if m.Contracts[1].JarFile != "contracts.jar" || m.Contracts[1].BinaryName != "com.org1.contract.StateReader" {
+		t.Errorf("the second contract is not match")
+	}
+
+	if m.Functions[0].ID != "state-function" || m.Functions[0].ClassFile != "StateFunction.class" {
+		t.Errorf("the function is not match")
+	}
+}
+
+func TestNewManifestFromJSON(t *testing.T) {
+	m, err := NewManifestFromJSON(`{"contracts":[{"id":"foo","class_file":"Foo.class","properties":{"bar":1}}]}`)
+
+	if err != nil {
+		t.Fatalf("can't load JSON: %v", err)
+	}
+
+	if m.RegisterCertificate || len(m.Contracts) != 1 || m.Contracts[0].ID != "foo" {
+		t.Errorf("Manifest is not match")
+	}
+
+	var invalids = []string{
+		`{"contracts":[{"class_file":"Foo.class"}]}`,
+		`{"contracts":[{"id":"foo"}]}`,
+		`{"contracts":[{"id":"foo","jar_file":"foo.jar"}]}`,
+		`{"contracts":[{"id":"foo","jar_file":"foo.jar","class_file":"Foo.class","binary_name":"Foo"}]}`,
+		`{"functions":[{"id":"foo"}]}`,
+		`{"contracts":[{"id":"foo","class_file":"Foo.class","argument":{"type":"map"}}]}`,
+		`{"contracts":[{"id":"foo","class_file":"Foo.class","argument":{"properties":{"a":{"type":"int"}}}}]}`,
+		`{"contracts":[{"id":"foo","class_file":"Foo.class","function_argument":{"type":"object"}}]}`,
+		`not JSON`,
+	}
+
+	for _, invalid := range invalids {
+		if _, err = NewManifestFromJSON(invalid); err == nil {
+			t.Errorf("should not be validated: %s", invalid)
+		}
+	}
+}
+
+func TestNewManifestFromFile(t *testing.T) {
+	var (
+		dir  = t.TempDir()
+		path = filepath.Join(dir, "manifest.yml")
+	)
+
+	os.WriteFile(path, []byte(`
+contracts:
+  - id: relative
+    class_file: Relative.class
+  - id: absolute
+    class_file: /contracts/Absolute.class
+`), 0600)
+
+	m, err := NewManifestFromFile(path)
+
+	if err != nil {
+		t.Fatalf("can't load the manifest file: %v", err)
+	}
+
+	if m.Contracts[0].ClassFile != filepath.Join(dir, "Relative.class") {
+		t.Errorf("relative paths should be resolved against the manifest file: %s", m.Contracts[0].ClassFile)
+	}
+
+	if m.Contracts[1].ClassFile != "/contracts/Absolute.class" {
+		t.Errorf("absolute paths should be kept: %s", m.Contracts[1].ClassFile)
+	}
+
+	os.WriteFile(filepath.Join(dir, "manifest.txt"), []byte(`{}`), 0600)
+
+	if _, err = NewManifestFromFile(filepath.Join(dir, "manifest.txt")); err == nil {
+		t.Errorf("should not load a manifest file of an unknown extension")
+	}
+}
+
+func TestNewManifestFromYAML_Schema(t *testing.T) {
+	m, err := NewManifestFromYAML(`
+contracts:
+  - id: transfer
+    class_file: Transfer.class
+    argument:
+      type: object
+      properties:
+        amount:
+          type: integer
+          minimum: 1
+        tags:
+          type: array
+          items:
+            type: string
+            maxLength: 8
+      required: [amount]
+    result:
+      type: object
+    function: audit
+    function_argument:
+      type: object
+`)
+
+	if err != nil {
+		t.Fatalf("can't load YAML: %v", err)
+	}
+
+	var c = m.Contracts[0]
+
+	if c.Argument == nil || c.Argument.Type != TypeObject || !c.Argument.IsRequired("amount") || c.Argument.IsRequired("tags") {
+		t.Fatalf("Argument is not match: %v", c.Argument)
+	}
+
+	if amount := c.Argument.Properties["amount"]; amount.Type != TypeInteger || amount.Minimum == nil || *amount.Minimum != 1 {
+		t.Errorf("the amount property is not match: %v", amount)
+	}
+
+	if tags := c.Argument.Properties["tags"]; tags.Items == nil || tags.Items.MaxLength == nil || *tags.Items.MaxLength != 8 {
+		t.Errorf("the items of the tags property are not match: %v", tags)
+	}
+
+	if c.Result == nil || c.Function != "audit" || c.FunctionArgument == nil {
+		t.Errorf("Result, Function or FunctionArgument is not match: %v", c)
+	}
+}
@@ -0,0 +1,48 @@
+package manifest
+
+// The types of Schema.
+const (
+	TypeObject  = "object"
+	TypeArray   = "array"
+	TypeString  = "string"
+	TypeInteger = "integer"
+	TypeNumber  = "number"
+	TypeBoolean = "boolean"
+)
+
+// Schema describes a JSON value with a subset of JSON Schema, e.g. the argument of a contract:
+//
+//	type: object
+//	properties:
+//	  customer_id:
+//	    type: integer
+//	    minimum: 0
+//	  customer_name:
+//	    type: string
+//	    maxLength: 64
+//	required: [customer_id]
+//
+// An object without Properties is any JSON object, and an empty Type is any JSON value.
+type Schema struct {
+	Type        string            `json:"type" validate:"omitempty,oneof=object array string integer number boolean"`
+	Description string            `json:"description"`
+	Properties  map[string]Schema `json:"properties" validate:"dive"`
+	Required    []string          `json:"required"`
+	Items       *Schema           `json:"items"`
+	Enum        []interface{}     `json:"enum"`
+	Minimum     *float64          `json:"minimum"`
+	Maximum     *float64          `json:"maximum"`
+	MinLength   *int              `json:"minLength" validate:"omitempty,min=0"`
+	MaxLength   *int              `json:"maxLength" validate:"omitempty,min=0"`
+}
+
+// IsRequired checks if the property is required in the object.
+func (s Schema) IsRequired(property string) bool {
+	for _, r := range s.Required {
+		if r == property {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,68 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	clientError "github.com/scalar-labs/scalardl-go-client-sdk/v3/client/error"
+	"github.com/scalar-labs/scalardl-go-client-sdk/v3/crypto"
+	"github.com/scalar-labs/scalardl-go-client-sdk/v3/ledger/asset"
+	"github.com/scalar-labs/scalardl-go-client-sdk/v3/ledger/statuscode"
+	"github.com/scalar-labs/scalardl-go-client-sdk/v3/rpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// RetrieveAssetProof retrieves the proof of the specified age of the asset from Ledger.
+// The latest age is retrieved if age is negative.
+func (s ClientService) RetrieveAssetProof(assetID string, age int) (proof asset.Proof, err error) {
+	var conns = s.acquire()
+	defer conns.release()
+
+	if conns.config.ClientMode != "CLIENT" {
+		return proof, clientError.NewClientError(statuscode.InvalidRequest, "wrong mode specified")
+	}
+
+	if assetID == "" {
+		return proof, fmt.Errorf("assetID cannot be empty")
+	}
+
+	if age > JavaMaxIntValue {
+		return proof, fmt.Errorf("invalid age specified")
+	}
+
+	var key crypto.KeyringEntry
+	if key, err = s.currentKey(); err != nil {
+		return
+	}
+
+	var (
+		trailer metadata.MD
+		request = &rpc.AssetProofRetrievalRequest{
+			AssetId:      assetID,
+			Age:          int32(age),
+			CertHolderId: s.identity.CertHolderID,
+			CertVersion:  uint32(key.Version),
+		}
+		response *rpc.AssetProofRetrievalResponse
+	)
+
+	if age < 0 {
+		request.Age = -1
+	}
+
+	if err = request.SignWith(key.Signer); err != nil {
+		return
+	}
+
+	var ledger = rpc.NewLedgerClient(conns.ledger)
+	if response, err = ledger.RetrieveAssetProof(context.Background(), request, grpc.Trailer(&trailer)); err != nil {
+		if trailer.Len() > 0 {
+			err = getClientErrorFromTrailer(trailer)
+		}
+
+		return
+	}
+
+	return asset.FromGRPC(response.GetProof()), nil
+}
@@ -2,59 +2,133 @@ package service
 
 import (
 	"context"
+	"fmt"
 
 	clientError "github.com/scalar-labs/scalardl-go-client-sdk/v3/client/error"
+	"github.com/scalar-labs/scalardl-go-client-sdk/v3/crypto"
+	"github.com/scalar-labs/scalardl-go-client-sdk/v3/ledger/model"
 	"github.com/scalar-labs/scalardl-go-client-sdk/v3/ledger/statuscode"
 	"github.com/scalar-labs/scalardl-go-client-sdk/v3/rpc"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/metadata"
 )
 
-// RegisterCertificate registers the certificate in the client config to Ledger and Auditor.
+// RegisterCertificate registers the current certificate version to Ledger and Auditor.
+// It returns the error of a failed registration first, or the CertificateAlreadyRegistered error
+// if the certificate has been registered before. Use RegisterCertificateWithResult to know the outcome in each server.
 func (s ClientService) RegisterCertificate() (err error) {
-	if s.clientConfig.ClientMode != "CLIENT" {
-		return clientError.NewClientError(
+	var result model.CertificateRegistrationResult
+
+	if result, err = s.RegisterCertificateWithResult(); err != nil {
+		return
+	}
+
+	return toRegistrationError(result)
+}
+
+// RegisterCertificateWithResult registers the current certificate version to Ledger and Auditor,
+// and reports the outcome in each server.
+// With HMAC authentication, it registers the current secret key version instead.
+// The registration is sent to Ledger even if it fails in Auditor,
+// so calling it again repairs a certificate registered only in one of them.
+// The returned error is only for the requests that can't be sent.
+func (s ClientService) RegisterCertificateWithResult() (result model.CertificateRegistrationResult, err error) {
+	var key crypto.KeyringEntry
+
+	if key, err = s.currentKey(); err != nil {
+		return
+	}
+
+	return s.registerCertificate(key)
+}
+
+// RegisterCertificateVersion registers the given certificate version in the keyring to Ledger and Auditor.
+// It doesn't switch the current version, so a new version can be registered before it is used.
+func (s ClientService) RegisterCertificateVersion(version int) (result model.CertificateRegistrationResult, err error) {
+	if s.identity.Keyring == nil {
+		return result, fmt.Errorf("no keyring is in the identity")
+	}
+
+	key, ok := s.identity.Keyring.Get(version)
+	if !ok {
+		return result, fmt.Errorf("version %d is not in the keyring", version)
+	}
+
+	return s.registerCertificate(key)
+}
+
+func (s ClientService) registerCertificate(key crypto.KeyringEntry) (result model.CertificateRegistrationResult, err error) {
+	if key.SecretKey != "" {
+		return s.registerSecret(key)
+	}
+
+	var conns = s.acquire()
+	defer conns.release()
+
+	if conns.config.ClientMode != "CLIENT" {
+		return result, clientError.NewClientError(
 			statuscode.InvalidRequest,
 			"wrong mode specified",
 		)
 	}
 
+	var request = &rpc.CertificateRegistrationRequest{
+		CertHolderId: s.identity.CertHolderID,
+		CertVersion:  (uint32)(key.Version),
+		CertPem:      key.Cert,
+	}
+
+	if conns.config.IsAuditorEnabled {
+		var (
+			privileged = rpc.NewAuditorPrivilegedClient(conns.auditorPrivileged)
+			trailer    metadata.MD
+		)
+
+		_, e := privileged.RegisterCert(context.Background(), request, grpc.Trailer(&trailer))
+		result.Auditor = toRegistrationOutcome(e, trailer, statuscode.CertificateAlreadyRegistered)
+	}
+
 	var (
-		trailer metadata.MD
-		request = &rpc.CertificateRegistrationRequest{
-			CertHolderId: s.clientConfig.CertHolderID,
-			CertVersion:  (uint32)(s.clientConfig.CertVersion),
-			CertPem:      s.clientConfig.Cert,
-		}
+		privileged = rpc.NewLedgerPrivilegedClient(conns.ledgerPrivileged)
+		trailer    metadata.MD
 	)
 
-	if s.clientConfig.IsAuditorEnabled {
-		var privileged = rpc.NewAuditorPrivilegedClient(s.auditorPrivilegedConnection)
-
-		if _, err = privileged.RegisterCert(
-			context.Background(),
-			request,
-			grpc.Trailer(&trailer),
-		); err != nil {
-			if trailer.Len() > 0 {
-				err = getClientErrorFromTrailer(trailer)
-			}
+	_, e := privileged.RegisterCert(context.Background(), request, grpc.Trailer(&trailer))
+	result.Ledger = toRegistrationOutcome(e, trailer, statuscode.CertificateAlreadyRegistered)
+
+	return
+}
+
+// toRegistrationError returns the error of a failed registration first,
+// or the already registered error of Ledger or Auditor.
+func toRegistrationError(result model.CertificateRegistrationResult) error {
+	for _, status := range []model.RegistrationStatus{model.Failed, model.AlreadyRegistered} {
+		if result.Ledger.Status == status {
+			return result.Ledger.Err
+		}
+
+		if result.Auditor.Status == status {
+			return result.Auditor.Err
 		}
 	}
 
-	var priviledged = rpc.NewLedgerPrivilegedClient(s.ledgerPrivilegedConnection)
+	return nil
+}
 
-	trailer = metadata.MD{}
+// toRegistrationOutcome converts the error of a registration request to the outcome.
+// alreadyRegistered is the status code that indicates the item has been registered before.
+func toRegistrationOutcome(err error, trailer metadata.MD, alreadyRegistered statuscode.StatusCode) model.RegistrationOutcome {
+	if err == nil {
+		return model.RegistrationOutcome{Status: model.Registered}
+	}
 
-	if _, err = priviledged.RegisterCert(
-		context.Background(),
-		request,
-		grpc.Trailer(&trailer),
-	); err != nil {
-		if trailer.Len() > 0 {
-			err = getClientErrorFromTrailer(trailer)
-		}
+	if trailer.Len() > 0 {
+		err = getClientErrorFromTrailer(trailer)
 	}
 
-	return
+	if isStatusCode(err, alreadyRegistered) {
+		return model.RegistrationOutcome{Status: model.AlreadyRegistered, Err: err}
+	}
+
+	return model.RegistrationOutcome{Status: model.Failed, Err: err}
 }
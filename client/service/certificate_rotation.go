@@ -0,0 +1,93 @@
+package service
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/scalar-labs/scalardl-go-client-sdk/v3/client/config"
+	"github.com/scalar-labs/scalardl-go-client-sdk/v3/ledger/model"
+)
+
+// AddCertificateVersion adds a version of the certificate and its private key to the keyring.
+// It doesn't register the certificate nor switch the current version.
+// The certificate is checked as NewClientService does, following CertExpiryPolicy and CertClockSkew of the current config.
+// Adding the same certificate again does nothing, but a version already in the keyring with another certificate is rejected,
+// so that the signer of the current version is never replaced.
+func (s ClientService) AddCertificateVersion(version int, cert string, privateKey string) error {
+	if s.identity.Keyring == nil {
+		return fmt.Errorf("no keyring is in the identity")
+	}
+
+	var conns = s.acquire()
+	var c = conns.config
+	conns.release()
+
+	c.AuthenticationMethod = config.AuthenticationMethodDigitalSignature
+	c.CertHolderID = s.identity.CertHolderID
+	c.CertVersion = version
+	c.Cert = cert
+	c.PrivateKey = privateKey
+
+	if err := checkCertificate(c, time.Now(), s.onCertificateWarning()); err != nil {
+		return err
+	}
+
+	if key, ok := s.identity.Keyring.Get(version); ok {
+		if key.Cert != cert {
+			return fmt.Errorf("version %d is already in the keyring with another certificate", version)
+		}
+
+		return nil
+	}
+
+	return s.identity.Keyring.Add(version, cert, privateKey)
+}
+
+// UseCertificateVersion switches the certificate version that requests are signed with.
+// The switch is atomic: each request is signed with either the previous or the given version consistently.
+func (s ClientService) UseCertificateVersion(version int) error {
+	if s.identity.Keyring == nil {
+		return fmt.Errorf("no keyring is in the identity")
+	}
+
+	return s.identity.Keyring.Use(version)
+}
+
+// RotateCertificate adds the new certificate version, registers it to Ledger and Auditor, and switches to it.
+// The current version is kept if the registration is not complete, and the version added by it is removed again.
+// The previous versions stay in the keyring to verify the signatures made by them.
+func (s ClientService) RotateCertificate(
+	version int,
+	cert string,
+	privateKey string,
+) (result model.CertificateRegistrationResult, err error) {
+	if s.identity.Keyring == nil {
+		return result, fmt.Errorf("no keyring is in the identity")
+	}
+
+	var _, existed = s.identity.Keyring.Get(version)
+
+	if err = s.AddCertificateVersion(version, cert, privateKey); err != nil {
+		return
+	}
+
+	if result, err = s.RegisterCertificateVersion(version); err == nil && !result.IsComplete() {
+		if result.Ledger.Status == model.Failed {
+			err = result.Ledger.Err
+		} else {
+			err = result.Auditor.Err
+		}
+	}
+
+	if err != nil {
+		if !existed {
+			s.identity.Keyring.Remove(version)
+		}
+
+		return
+	}
+
+	err = s.UseCertificateVersion(version)
+
+	return
+}
@@ -0,0 +1,82 @@
+package service
+
+import (
+	"crypto/x509"
+	"fmt"
+	"time"
+
+	"github.com/scalar-labs/scalardl-go-client-sdk/v3/client/config"
+	"github.com/scalar-labs/scalardl-go-client-sdk/v3/crypto"
+	"github.com/scalar-labs/scalardl-go-client-sdk/v3/crypto/keygen"
+)
+
+// CertificateExpiry returns when the current certificate version expires,
+// so that services can alarm well before the rotation is needed.
+func (s ClientService) CertificateExpiry() (expiry time.Time, err error) {
+	var key crypto.KeyringEntry
+
+	if key, err = s.currentKey(); err != nil {
+		return
+	}
+
+	if key.Cert == "" {
+		return expiry, fmt.Errorf("version %d in the keyring is not a certificate", key.Version)
+	}
+
+	var parsed *x509.Certificate
+	if parsed, err = crypto.ParseCertificate([]byte(key.Cert)); err != nil {
+		return
+	}
+
+	return parsed.NotAfter, nil
+}
+
+// checkCertificate checks if the certificate in the client config matches the private key,
+// and if it is valid at the given time according to CertExpiryPolicy and CertClockSkew.
+// The key pair is always checked. With CertExpiryPolicyIgnore, the validity period is not checked.
+// With CertExpiryPolicyWarn, the invalid period is passed to warn instead of failing, unless warn is nil.
+func checkCertificate(c config.ClientConfig, now time.Time, warn func(error)) (err error) {
+	if c.AuthenticationMethod == config.AuthenticationMethodHMAC {
+		return
+	}
+
+	if err = keygen.CheckKeyPair(c.Cert, c.PrivateKey); err != nil || c.CertExpiryPolicy == config.CertExpiryPolicyIgnore {
+		return
+	}
+
+	var parsed *x509.Certificate
+	if parsed, err = crypto.ParseCertificate([]byte(c.Cert)); err != nil {
+		return
+	}
+
+	var invalid error
+
+	if now.Add(c.CertClockSkew).Before(parsed.NotBefore) {
+		invalid = fmt.Errorf("certificate %s (version %d) is not valid until %s", c.CertHolderID, c.CertVersion, parsed.NotBefore)
+	} else if now.Add(-c.CertClockSkew).After(parsed.NotAfter) {
+		invalid = fmt.Errorf("certificate %s (version %d) expired at %s", c.CertHolderID, c.CertVersion, parsed.NotAfter)
+	}
+
+	if invalid == nil {
+		return
+	}
+
+	if c.CertExpiryPolicy == config.CertExpiryPolicyFail {
+		return invalid
+	}
+
+	if warn != nil {
+		warn(invalid)
+	}
+
+	return
+}
+
+// onCertificateWarning returns the hook given by ClientServiceOptions, or nil.
+func (s ClientService) onCertificateWarning() func(error) {
+	if s.shared == nil {
+		return nil
+	}
+
+	return s.shared.onCertificateWarning
+}
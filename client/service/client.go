@@ -0,0 +1,52 @@
+package service
+
+import (
+	"github.com/scalar-labs/scalardl-go-client-sdk/v3/client/manifest"
+	"github.com/scalar-labs/scalardl-go-client-sdk/v3/json"
+	"github.com/scalar-labs/scalardl-go-client-sdk/v3/ledger/asset"
+	"github.com/scalar-labs/scalardl-go-client-sdk/v3/ledger/model"
+)
+
+// Client defines the requests that ClientService sends to Ledger and Auditor.
+// Application code can depend on it rather than ClientService to substitute the mock package in unit tests.
+// The methods to configure ClientService, such as WithIdentity and UpdateConfig,
+// and the deprecated ValidateLedger are not in it.
+type Client interface {
+	RegisterCertificate() error
+	RegisterCertificateWithResult() (model.CertificateRegistrationResult, error)
+	RegisterCertificateVersion(version int) (model.CertificateRegistrationResult, error)
+	RotateCertificate(version int, cert string, privateKey string) (model.CertificateRegistrationResult, error)
+	RegisterSecret() error
+	RegisterSecretWithResult() (model.CertificateRegistrationResult, error)
+	EnsureCertificate() error
+
+	RegisterContract(id string, name string, contractBytes []byte, properties json.Object) error
+	RegisterContractFromClassFile(id string, path string, properties json.Object) error
+	RegisterContractFromJar(id string, path string, name string, properties json.Object) error
+	EnsureContract(id string, name string, contractBytes []byte, properties json.Object) error
+	ListContracts(id string) (json.Object, error)
+
+	RegisterFunction(id string, name string, functionBytes []byte) error
+	RegisterFunctionFromClassFile(id string, path string) error
+	RegisterFunctionFromJar(id string, path string, name string) error
+
+	Deploy(m manifest.Manifest) (model.DeploymentReport, error)
+
+	ExecuteContract(id string, argument json.Object, functionArgument json.Object) (model.ContractExecutionResult, error)
+	ExecuteContractWithOptions(
+		id string,
+		argument json.Object,
+		functionArgument json.Object,
+		options ContractExecutionOptions,
+	) (model.ContractExecutionResult, error)
+
+	ValidateAsset(assetID string) (model.LedgerValidationResult, error)
+	ValidateLedgerRange(assetID string, startAge int, endAge int) (model.LedgerValidationResult, error)
+	ValidateLedgers(assetIDs []string, options LedgersValidationOptions) (model.LedgersValidationReport, error)
+	RetrieveAssetProof(assetID string, age int) (asset.Proof, error)
+	AbortExecution(nonce string) (model.TransactionState, error)
+
+	Close()
+}
+
+var _ Client = ClientService{}
@@ -1,95 +1,63 @@
 package service
 
 import (
-	"crypto/x509"
-	"fmt"
+	"time"
 
 	"github.com/scalar-labs/scalardl-go-client-sdk/v3/client/config"
 	"github.com/scalar-labs/scalardl-go-client-sdk/v3/crypto"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials"
 )
 
 // ClientService defines the interface of the client service.
+// It consists of the connections to Ledger and Auditor, and the identity that signs requests.
+// Use WithIdentity to send requests on behalf of other identities with the same connections.
 type ClientService struct {
-	clientConfig                config.ClientConfig
-	signer                      crypto.Signer
-	ledgerConnection            *grpc.ClientConn
-	ledgerPrivilegedConnection  *grpc.ClientConn
-	auditorConnection           *grpc.ClientConn
-	auditorPrivilegedConnection *grpc.ClientConn
+	identity Identity
+	shared   *shared
+}
+
+// ClientServiceOptions defines the optional parameters of NewClientServiceWithOptions.
+type ClientServiceOptions struct {
+	// DialOptions are added to the options to dial Ledger and Auditor after the transport credentials,
+	// e.g. grpc.WithContextDialer to connect to in-process servers, or interceptors.
+	// They are kept for the connections made by UpdateConfig.
+	DialOptions []grpc.DialOption
+
+	// OnCertificateWarning is called with the error of an expired or not yet valid certificate
+	// when CertExpiryPolicy is warn. It applies to the certificates given by UpdateConfig,
+	// AddCertificateVersion and RotateCertificate as well. The warnings are dropped if it is nil.
+	OnCertificateWarning func(error)
 }
 
 // NewClientService creates ClientService instance.
 func NewClientService(c config.ClientConfig) (s ClientService, err error) {
+	return NewClientServiceWithOptions(c, ClientServiceOptions{})
+}
+
+// NewClientServiceWithOptions creates ClientService instance with the given options.
+func NewClientServiceWithOptions(c config.ClientConfig, options ClientServiceOptions) (s ClientService, err error) {
 	if err = c.Validate(); err != nil {
 		return
 	}
 
-	s.clientConfig = c
-
-	if s.signer, err = crypto.NewEcdsaSha256Signer([]byte(c.PrivateKey)); err != nil {
+	if err = checkCertificate(c, time.Now(), options.OnCertificateWarning); err != nil {
 		return
 	}
 
-	var opts = make([]grpc.DialOption, 0)
-
-	if c.IsTLSEnabled {
-		var certPool *x509.CertPool = x509.NewCertPool()
-		if ok := certPool.AppendCertsFromPEM([]byte(c.TLSCaRootCert)); !ok {
-			err = fmt.Errorf("TLSCaRootCert is not valid")
-			return
-		}
-
-		var creds credentials.TransportCredentials = credentials.NewClientTLSFromCert(certPool, c.LedgerHost)
-		opts = append(opts, grpc.WithTransportCredentials(creds))
-	} else {
-		opts = append(opts, grpc.WithInsecure())
-	}
-
-	if s.ledgerConnection, err = grpc.Dial(
-		fmt.Sprintf("%s:%d", c.LedgerHost, c.LedgerPort),
-		opts...,
-	); err != nil {
+	if s.identity, err = NewIdentityFromConfig(c); err != nil {
 		return
 	}
 
-	if s.ledgerPrivilegedConnection, err = grpc.Dial(
-		fmt.Sprintf("%s:%d", c.LedgerHost, c.LedgerPrivilegedPort),
-		opts...,
-	); err != nil {
+	var conns *connections
+	if conns, err = newConnections(c, options.DialOptions); err != nil {
 		return
 	}
 
-	if c.IsAuditorEnabled {
-		opts = make([]grpc.DialOption, 0)
-
-		if c.IsAuditorTLSEnabled {
-			var certPool *x509.CertPool = x509.NewCertPool()
-			if ok := certPool.AppendCertsFromPEM([]byte(c.AuditorTLSCaRootCert)); !ok {
-				err = fmt.Errorf("AuditorTLSCaRootCert is not valid")
-				return
-			}
-
-			var creds credentials.TransportCredentials = credentials.NewClientTLSFromCert(certPool, c.AuditorHost)
-			opts = append(opts, grpc.WithTransportCredentials(creds))
-		} else {
-			opts = append(opts, grpc.WithInsecure())
-		}
-
-		if s.auditorConnection, err = grpc.Dial(
-			fmt.Sprintf("%s:%d", c.AuditorHost, c.AuditorPort),
-			opts...,
-		); err != nil {
-			return
-		}
-
-		if s.auditorPrivilegedConnection, err = grpc.Dial(
-			fmt.Sprintf("%s:%d", c.AuditorHost, c.AuditorPrivilegedPort),
-			opts...,
-		); err != nil {
-			return
-		}
+	s.shared = &shared{
+		connections:          conns,
+		keyring:              s.identity.Keyring,
+		dialOptions:          options.DialOptions,
+		onCertificateWarning: options.OnCertificateWarning,
 	}
 
 	return
@@ -97,19 +65,18 @@ func NewClientService(c config.ClientConfig) (s ClientService, err error) {
 
 // Close shuts down underlying connections.
 func (s ClientService) Close() {
-	if s.ledgerConnection != nil {
-		s.ledgerConnection.Close()
+	if s.shared == nil {
+		return
 	}
 
-	if s.ledgerPrivilegedConnection != nil {
-		s.ledgerPrivilegedConnection.Close()
-	}
+	s.shared.mutex.RLock()
+	defer s.shared.mutex.RUnlock()
 
-	if s.auditorConnection != nil {
-		s.auditorConnection.Close()
-	}
+	s.shared.connections.close()
+}
 
-	if s.auditorPrivilegedConnection != nil {
-		s.auditorPrivilegedConnection.Close()
-	}
+// Keyring returns the certificate versions that the service signs requests with.
+// It is shared among the copies of the service with the same identity.
+func (s ClientService) Keyring() *crypto.Keyring {
+	return s.identity.Keyring
 }
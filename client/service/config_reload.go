@@ -0,0 +1,225 @@
+package service
+
+import (
+	"fmt"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/scalar-labs/scalardl-go-client-sdk/v3/client/config"
+)
+
+// reloadDelay is how long WatchConfig waits for the burst of file events of a change to settle.
+const reloadDelay = 100 * time.Millisecond
+
+// ConfigWatchOptions defines the optional parameters of WatchConfig.
+type ConfigWatchOptions struct {
+	// Paths are the other files to watch, such as the certificate and the TLS CA root certificate
+	// referenced by the config file. A change of any of them reloads the config file.
+	Paths []string
+
+	// OnReload is called with the config applied by a reload.
+	OnReload func(config.ClientConfig)
+
+	// OnError is called with the error of a reload. The service keeps the previous config on errors.
+	OnError func(error)
+}
+
+// UpdateConfig applies the given config to the service and all of its copies.
+// The connections are replaced, and the requests using the previous connections complete on them before they are closed.
+// A changed certificate or secret key in the config is added to the keyring of the identity in the config,
+// and becomes the current version. The certificate holder ID can't be changed.
+// On any error, the service keeps the previous config.
+func (s ClientService) UpdateConfig(c config.ClientConfig) (err error) {
+	if s.shared == nil {
+		return fmt.Errorf("ClientService is not created by NewClientService")
+	}
+
+	if err = c.Validate(); err != nil {
+		return
+	}
+
+	if err = checkCertificate(c, time.Now(), s.shared.onCertificateWarning); err != nil {
+		return
+	}
+
+	s.shared.updating.Lock()
+	defer s.shared.updating.Unlock()
+
+	s.shared.mutex.RLock()
+	var current = s.shared.connections.config
+	s.shared.mutex.RUnlock()
+
+	if c == current {
+		return
+	}
+
+	if c.CertHolderID != current.CertHolderID {
+		return fmt.Errorf("CertHolderID can't be changed from %s to %s by updating the config", current.CertHolderID, c.CertHolderID)
+	}
+
+	var conns *connections
+	if conns, err = newConnections(c, s.shared.dialOptions); err != nil {
+		return
+	}
+
+	if err = s.updateKeyring(current, c); err != nil {
+		conns.close()
+		return
+	}
+
+	s.shared.replace(conns)
+
+	return
+}
+
+// updateKeyring adds the certificate or the secret key in the config to the keyring if it is changed, and uses it.
+func (s ClientService) updateKeyring(current config.ClientConfig, c config.ClientConfig) (err error) {
+	var keyring = s.shared.keyring
+
+	if c.AuthenticationMethod == config.AuthenticationMethodHMAC {
+		if c.AuthenticationMethod == current.AuthenticationMethod &&
+			c.SecretKey == current.SecretKey &&
+			c.SecretVersion == current.SecretVersion {
+			return
+		}
+
+		if err = keyring.AddSecret(c.SecretVersion, c.SecretKey); err != nil {
+			return
+		}
+
+		return keyring.Use(c.SecretVersion)
+	}
+
+	if c.AuthenticationMethod == current.AuthenticationMethod &&
+		c.Cert == current.Cert &&
+		c.PrivateKey == current.PrivateKey &&
+		c.CertVersion == current.CertVersion {
+		return
+	}
+
+	if err = keyring.Add(c.CertVersion, c.Cert, c.PrivateKey); err != nil {
+		return
+	}
+
+	return keyring.Use(c.CertVersion)
+}
+
+// WatchConfig watches the config file at the given path, and applies it with UpdateConfig on changes.
+// The file is loaded by config.LoadClientConfig, so the environment variables override it.
+// Files replaced by renames or symbolic links, as in Kubernetes ConfigMaps and Secrets, are also followed.
+// Call the returned stop function to stop watching.
+func (s ClientService) WatchConfig(path string, options ConfigWatchOptions) (stop func(), err error) {
+	var watcher *fsnotify.Watcher
+
+	if watcher, err = fsnotify.NewWatcher(); err != nil {
+		return
+	}
+
+	var (
+		targets   = make(map[string]string)
+		dirs      = make(map[string]bool)
+		done      = make(chan struct{})
+		stopped   = make(chan struct{})
+		realPaths = func() map[string]string {
+			paths := make(map[string]string)
+			for target := range targets {
+				paths[target], _ = filepath.EvalSymlinks(target)
+			}
+			return paths
+		}
+	)
+
+	for _, p := range append([]string{path}, options.Paths...) {
+		var abs string
+		if abs, err = filepath.Abs(p); err != nil {
+			watcher.Close()
+			return
+		}
+
+		targets[abs] = ""
+
+		if dir := filepath.Dir(abs); !dirs[dir] {
+			if err = watcher.Add(dir); err != nil {
+				watcher.Close()
+				return
+			}
+
+			dirs[dir] = true
+		}
+	}
+
+	targets = realPaths()
+
+	var report = func(err error) {
+		if options.OnError != nil {
+			options.OnError(err)
+		}
+	}
+
+	go func() {
+		defer close(stopped)
+
+		var pending <-chan time.Time
+
+		for {
+			select {
+			case event, ok := <-watcher.Events:
+				if !ok {
+					return
+				}
+
+				var changed bool
+
+				if _, ok := targets[filepath.Clean(event.Name)]; ok && event.Op&(fsnotify.Write|fsnotify.Create) != 0 {
+					changed = true
+				}
+
+				for target, real := range realPaths() {
+					if real != "" && real != targets[target] {
+						targets[target] = real
+						changed = true
+					}
+				}
+
+				if changed {
+					pending = time.After(reloadDelay)
+				}
+			case <-pending:
+				pending = nil
+
+				c, err := config.LoadClientConfig(path)
+				if err == nil {
+					err = s.UpdateConfig(c)
+				}
+
+				if err != nil {
+					report(fmt.Errorf("failed to reload %s: %w", path, err))
+				} else if options.OnReload != nil {
+					options.OnReload(c)
+				}
+			case err, ok := <-watcher.Errors:
+				if !ok {
+					return
+				}
+
+				report(err)
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	var once sync.Once
+
+	stop = func() {
+		once.Do(func() {
+			close(done)
+			<-stopped
+			watcher.Close()
+		})
+	}
+
+	return
+}
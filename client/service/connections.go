@@ -0,0 +1,176 @@
+package service
+
+import (
+	"crypto/x509"
+	"fmt"
+	"sync"
+
+	"github.com/scalar-labs/scalardl-go-client-sdk/v3/client/config"
+	"github.com/scalar-labs/scalardl-go-client-sdk/v3/crypto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+// connections holds the gRPC connections to Ledger and Auditor along with the config they are made for.
+// They don't depend on any identity, so they are shared by all the identities of a ClientService.
+type connections struct {
+	config            config.ClientConfig
+	ledger            *grpc.ClientConn
+	ledgerPrivileged  *grpc.ClientConn
+	auditor           *grpc.ClientConn
+	auditorPrivileged *grpc.ClientConn
+
+	// inFlight counts the requests using the connections, which are closed after the requests once replaced.
+	inFlight sync.WaitGroup
+}
+
+// shared holds the state shared by all the copies of a ClientService:
+// the current connections, and the keyring of the identity in the config.
+// UpdateConfig replaces the connections without failing the requests using the previous ones.
+type shared struct {
+	mutex       sync.RWMutex
+	connections *connections
+	keyring     *crypto.Keyring
+	dialOptions []grpc.DialOption
+
+	// onCertificateWarning is ClientServiceOptions.OnCertificateWarning.
+	onCertificateWarning func(error)
+
+	// updating serializes UpdateConfig.
+	updating sync.Mutex
+}
+
+// acquire returns the current connections, which are not closed by UpdateConfig until they are released.
+func (s ClientService) acquire() (c *connections) {
+	if s.shared == nil {
+		c = &connections{}
+		c.inFlight.Add(1)
+
+		return
+	}
+
+	s.shared.mutex.RLock()
+	defer s.shared.mutex.RUnlock()
+
+	c = s.shared.connections
+	c.inFlight.Add(1)
+
+	return
+}
+
+// release marks the end of a request using the connections.
+func (c *connections) release() {
+	c.inFlight.Done()
+}
+
+// replace swaps the current connections with the given ones,
+// and closes the previous ones after the requests using them are done.
+func (s *shared) replace(c *connections) {
+	s.mutex.Lock()
+	previous := s.connections
+	s.connections = c
+	s.mutex.Unlock()
+
+	go func() {
+		previous.inFlight.Wait()
+		previous.close()
+	}()
+}
+
+// newConnections dials Ledger and Auditor according to the client config with the additional dial options.
+// The connections already established are closed if any of them fails.
+func newConnections(c config.ClientConfig, dialOptions []grpc.DialOption) (conns *connections, err error) {
+	conns = &connections{config: c}
+
+	defer func() {
+		if err != nil {
+			conns.close()
+			conns = nil
+		}
+	}()
+
+	var opts = make([]grpc.DialOption, 0)
+
+	if c.IsTLSEnabled {
+		var certPool *x509.CertPool = x509.NewCertPool()
+		if ok := certPool.AppendCertsFromPEM([]byte(c.TLSCaRootCert)); !ok {
+			err = fmt.Errorf("TLSCaRootCert is not valid")
+			return
+		}
+
+		var creds credentials.TransportCredentials = credentials.NewClientTLSFromCert(certPool, c.LedgerHost)
+		opts = append(opts, grpc.WithTransportCredentials(creds))
+	} else {
+		opts = append(opts, grpc.WithInsecure())
+	}
+
+	opts = append(opts, dialOptions...)
+
+	if conns.ledger, err = grpc.Dial(
+		fmt.Sprintf("%s:%d", c.LedgerHost, c.LedgerPort),
+		opts...,
+	); err != nil {
+		return
+	}
+
+	if conns.ledgerPrivileged, err = grpc.Dial(
+		fmt.Sprintf("%s:%d", c.LedgerHost, c.LedgerPrivilegedPort),
+		opts...,
+	); err != nil {
+		return
+	}
+
+	if c.IsAuditorEnabled {
+		opts = make([]grpc.DialOption, 0)
+
+		if c.IsAuditorTLSEnabled {
+			var certPool *x509.CertPool = x509.NewCertPool()
+			if ok := certPool.AppendCertsFromPEM([]byte(c.AuditorTLSCaRootCert)); !ok {
+				err = fmt.Errorf("AuditorTLSCaRootCert is not valid")
+				return
+			}
+
+			var creds credentials.TransportCredentials = credentials.NewClientTLSFromCert(certPool, c.AuditorHost)
+			opts = append(opts, grpc.WithTransportCredentials(creds))
+		} else {
+			opts = append(opts, grpc.WithInsecure())
+		}
+
+		opts = append(opts, dialOptions...)
+
+		if conns.auditor, err = grpc.Dial(
+			fmt.Sprintf("%s:%d", c.AuditorHost, c.AuditorPort),
+			opts...,
+		); err != nil {
+			return
+		}
+
+		if conns.auditorPrivileged, err = grpc.Dial(
+			fmt.Sprintf("%s:%d", c.AuditorHost, c.AuditorPrivilegedPort),
+			opts...,
+		); err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+// close shuts down the connections.
+func (c *connections) close() {
+	if c.ledger != nil {
+		c.ledger.Close()
+	}
+
+	if c.ledgerPrivileged != nil {
+		c.ledgerPrivileged.Close()
+	}
+
+	if c.auditor != nil {
+		c.auditor.Close()
+	}
+
+	if c.auditorPrivileged != nil {
+		c.auditorPrivileged.Close()
+	}
+}
@@ -17,13 +17,39 @@ import (
 	"google.golang.org/grpc/metadata"
 )
 
+// ContractExecutionOptions defines the optional parameters of a contract execution.
+type ContractExecutionOptions struct {
+	// OrderingKeys are the keys Auditor uses to order the execution against concurrent ones.
+	// Executions with disjoint ordering keys don't have to wait for each other.
+	OrderingKeys []string
+
+	// PreExecution executes the contract in Ledger without committing the states.
+	// The result and the proofs are what the execution would produce.
+	// Auditor is not involved in pre-executions.
+	PreExecution bool
+}
+
 // ExecuteContract executes a registered contract.
 func (s ClientService) ExecuteContract(
 	id string,
 	argument json.Object,
 	functionArgument json.Object,
 ) (result model.ContractExecutionResult, err error) {
-	if s.clientConfig.ClientMode != "CLIENT" {
+	return s.ExecuteContractWithOptions(id, argument, functionArgument, ContractExecutionOptions{})
+}
+
+// ExecuteContractWithOptions executes a registered contract with the given options.
+// A nonce is added to the argument unless it is given, and the result tells the nonce either way.
+func (s ClientService) ExecuteContractWithOptions(
+	id string,
+	argument json.Object,
+	functionArgument json.Object,
+	options ContractExecutionOptions,
+) (result model.ContractExecutionResult, err error) {
+	var conns = s.acquire()
+	defer conns.release()
+
+	if conns.config.ClientMode != "CLIENT" {
 		return result, clientError.NewClientError(statuscode.InvalidRequest, "wrong mode specified")
 	}
 
@@ -43,39 +69,42 @@ func (s ClientService) ExecuteContract(
 		argument["nonce"] = uuid.NewString()
 	}
 
+	var key crypto.KeyringEntry
+	if key, err = s.currentKey(); err != nil {
+		return
+	}
+
 	var (
-		signer  crypto.Signer
 		request = &rpc.ContractExecutionRequest{
 			ContractId:       id,
-			CertHolderId:     s.clientConfig.CertHolderID,
-			CertVersion:      uint32(s.clientConfig.CertVersion),
+			CertHolderId:     s.identity.CertHolderID,
+			CertVersion:      uint32(key.Version),
 			ContractArgument: argument.String(),
+			PreExecution:     options.PreExecution,
+			OrderingKeys:     options.OrderingKeys,
 		}
+		isAuditorInvolved = conns.config.IsAuditorEnabled && !options.PreExecution
 	)
 
 	if functionArgument != nil {
 		request.FunctionArgument = functionArgument.String()
 	}
 
-	if signer, err = crypto.NewEcdsaSha256Signer([]byte(s.clientConfig.PrivateKey)); err != nil {
-		return
-	}
-
-	if err = request.SignWith(signer); err != nil {
+	if err = request.SignWith(key.Signer); err != nil {
 		return
 	}
 
 	var (
 		auditor             rpc.AuditorClient
-		ledger              = rpc.NewLedgerClient(s.ledgerConnection)
+		ledger              = rpc.NewLedgerClient(conns.ledger)
 		trailer             metadata.MD
 		responseFromLedger  *rpc.ContractExecutionResponse
 		responseFromAuditor *rpc.ContractExecutionResponse
 	)
 
 	// send the ordering request to Auditor first to get Auditor's signature.
-	if s.clientConfig.IsAuditorEnabled {
-		auditor = rpc.NewAuditorClient(s.auditorConnection)
+	if isAuditorInvolved {
+		auditor = rpc.NewAuditorClient(conns.auditor)
 		trailer = metadata.MD{}
 
 		var ordered *rpc.ExecutionOrderingResponse
@@ -100,7 +129,7 @@ func (s ClientService) ExecuteContract(
 	}
 
 	// send the execution validation reqeust to Auditor.
-	if s.clientConfig.IsAuditorEnabled {
+	if isAuditorInvolved {
 		trailer = metadata.MD{}
 
 		if responseFromAuditor, err = auditor.ValidateExecution(
@@ -149,6 +178,8 @@ func (s ClientService) ExecuteContract(
 	}
 
 	result.Result, _ = json.FromJSON(responseFromLedger.Result)
+	result.RawResult = responseFromLedger.Result
+	result.Nonce = fmt.Sprint(argument["nonce"])
 
 	for _, p := range responseFromLedger.GetProofs() {
 		result.Proofs = append(result.Proofs, asset.FromGRPC(p))
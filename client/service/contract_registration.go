@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/scalar-labs/scalardl-go-client-sdk/v3/classfile"
 	clientError "github.com/scalar-labs/scalardl-go-client-sdk/v3/client/error"
 	"github.com/scalar-labs/scalardl-go-client-sdk/v3/crypto"
 	"github.com/scalar-labs/scalardl-go-client-sdk/v3/json"
@@ -14,13 +15,18 @@ import (
 )
 
 // RegisterContract registers contract to Scalar DL networks.
+// The contract is registered to Ledger even if it is already registered in Auditor,
+// so calling it again repairs a contract registered only in Auditor.
 func (s ClientService) RegisterContract(
 	id string,
 	name string,
 	contractBytes []byte,
 	properties json.Object,
 ) (err error) {
-	if s.clientConfig.ClientMode != "CLIENT" {
+	var conns = s.acquire()
+	defer conns.release()
+
+	if conns.config.ClientMode != "CLIENT" {
 		return clientError.NewClientError(statuscode.InvalidRequest, "wrong mode specified")
 	}
 
@@ -36,15 +42,19 @@ func (s ClientService) RegisterContract(
 		return fmt.Errorf("contractBytes cannot be nil")
 	}
 
+	var key crypto.KeyringEntry
+	if key, err = s.currentKey(); err != nil {
+		return
+	}
+
 	var (
-		signer  crypto.Signer
 		trailer metadata.MD
 		request = &rpc.ContractRegistrationRequest{
 			ContractId:         id,
 			ContractBinaryName: name,
 			ContractByteCode:   contractBytes,
-			CertHolderId:       s.clientConfig.CertHolderID,
-			CertVersion:        uint32(s.clientConfig.CertVersion),
+			CertHolderId:       s.identity.CertHolderID,
+			CertVersion:        uint32(key.Version),
 		}
 	)
 
@@ -52,27 +62,26 @@ func (s ClientService) RegisterContract(
 		request.ContractProperties = properties.String()
 	}
 
-	if signer, err = crypto.NewEcdsaSha256Signer([]byte(s.clientConfig.PrivateKey)); err != nil {
-		return
-	}
-
-	if err = request.SignWith(signer); err != nil {
+	if err = request.SignWith(key.Signer); err != nil {
 		return
 	}
 
-	if s.clientConfig.IsAuditorEnabled {
-		var auditor = rpc.NewAuditorClient(s.auditorConnection)
+	if conns.config.IsAuditorEnabled {
+		var auditor = rpc.NewAuditorClient(conns.auditor)
 		if _, err := auditor.RegisterContract(context.Background(), request, grpc.Trailer(&trailer)); err != nil {
 			if trailer.Len() > 0 {
 				err = getClientErrorFromTrailer(trailer)
 			}
 
-			return err
+			// the contract may be registered only in Auditor by an interrupted registration, so Ledger is tried as well.
+			if !isStatusCode(err, statuscode.ContractAlreadyRegistered) {
+				return err
+			}
 		}
 	}
 
 	trailer = metadata.MD{}
-	var ledger = rpc.NewLedgerClient(s.ledgerConnection)
+	var ledger = rpc.NewLedgerClient(conns.ledger)
 	if _, err := ledger.RegisterContract(context.Background(), request, grpc.Trailer(&trailer)); err != nil {
 		if trailer.Len() > 0 {
 			err = getClientErrorFromTrailer(trailer)
@@ -83,3 +92,35 @@ func (s ClientService) RegisterContract(
 
 	return
 }
+
+// RegisterContractFromClassFile registers the Java class file at the given path as a contract.
+// The binary name of the contract is derived from the class file.
+func (s ClientService) RegisterContractFromClassFile(
+	id string,
+	path string,
+	properties json.Object,
+) (err error) {
+	var c classfile.ClassFile
+
+	if c, err = classfile.ReadFile(path); err != nil {
+		return
+	}
+
+	return s.RegisterContract(id, c.BinaryName, c.Bytes, properties)
+}
+
+// RegisterContractFromJar registers the class of the given binary name in the jar file at the given path as a contract.
+func (s ClientService) RegisterContractFromJar(
+	id string,
+	path string,
+	name string,
+	properties json.Object,
+) (err error) {
+	var c classfile.ClassFile
+
+	if c, err = classfile.ReadFromJar(path, name); err != nil {
+		return
+	}
+
+	return s.RegisterContract(id, c.BinaryName, c.Bytes, properties)
+}
@@ -0,0 +1,54 @@
+package service
+
+import (
+	"context"
+
+	clientError "github.com/scalar-labs/scalardl-go-client-sdk/v3/client/error"
+	"github.com/scalar-labs/scalardl-go-client-sdk/v3/crypto"
+	"github.com/scalar-labs/scalardl-go-client-sdk/v3/json"
+	"github.com/scalar-labs/scalardl-go-client-sdk/v3/ledger/statuscode"
+	"github.com/scalar-labs/scalardl-go-client-sdk/v3/rpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// ListContracts lists the contracts registered by the certificate in the client config.
+// The result is keyed by contract IDs. Only the specified contract is listed if id is not empty.
+func (s ClientService) ListContracts(id string) (contracts json.Object, err error) {
+	var conns = s.acquire()
+	defer conns.release()
+
+	if conns.config.ClientMode != "CLIENT" {
+		return contracts, clientError.NewClientError(statuscode.InvalidRequest, "wrong mode specified")
+	}
+
+	var key crypto.KeyringEntry
+	if key, err = s.currentKey(); err != nil {
+		return
+	}
+
+	var (
+		trailer metadata.MD
+		request = &rpc.ContractsListingRequest{
+			ContractId:   id,
+			CertHolderId: s.identity.CertHolderID,
+			CertVersion:  uint32(key.Version),
+		}
+		response *rpc.ContractsListingResponse
+	)
+
+	if err = request.SignWith(key.Signer); err != nil {
+		return
+	}
+
+	var ledger = rpc.NewLedgerClient(conns.ledger)
+	if response, err = ledger.ListContracts(context.Background(), request, grpc.Trailer(&trailer)); err != nil {
+		if trailer.Len() > 0 {
+			err = getClientErrorFromTrailer(trailer)
+		}
+
+		return
+	}
+
+	return json.FromJSON(response.GetJson())
+}
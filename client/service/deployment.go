@@ -0,0 +1,85 @@
+package service
+
+import (
+	"fmt"
+
+	"github.com/scalar-labs/scalardl-go-client-sdk/v3/classfile"
+	"github.com/scalar-labs/scalardl-go-client-sdk/v3/client/manifest"
+	"github.com/scalar-labs/scalardl-go-client-sdk/v3/ledger/model"
+)
+
+// Deploy registers the certificate, the contracts and the functions declared in the manifest.
+// It is idempotent: the certificate and the contracts already registered are reported rather than failed,
+// as long as they match the manifest as EnsureCertificate and EnsureContract check.
+// Functions are always registered since Scalar DL overwrites them.
+// Deploy stops at the first error, and the returned report tells what has been done until then.
+func (s ClientService) Deploy(m manifest.Manifest) (report model.DeploymentReport, err error) {
+	if err = m.Validate(); err != nil {
+		return
+	}
+
+	if m.RegisterCertificate {
+		var registered bool
+
+		if registered, err = s.ensureCertificate(); err != nil {
+			return
+		}
+
+		report.CertificateRegistered = registered
+		report.CertificateAlreadyRegistered = !registered
+	}
+
+	for _, c := range m.Contracts {
+		var class classfile.ClassFile
+
+		if class, err = readClass(c.ClassFile, c.JarFile, c.BinaryName); err != nil {
+			return
+		}
+
+		var registered bool
+
+		if registered, err = s.ensureContract(c.ID, class.BinaryName, class.Bytes, c.Properties); err != nil {
+			return
+		}
+
+		if registered {
+			report.RegisteredContracts = append(report.RegisteredContracts, c.ID)
+		} else {
+			report.AlreadyRegisteredContracts = append(report.AlreadyRegisteredContracts, c.ID)
+		}
+	}
+
+	for _, f := range m.Functions {
+		var class classfile.ClassFile
+
+		if class, err = readClass(f.ClassFile, f.JarFile, f.BinaryName); err != nil {
+			return
+		}
+
+		if err = s.RegisterFunction(f.ID, class.BinaryName, class.Bytes); err != nil {
+			return
+		}
+
+		report.UpsertedFunctions = append(report.UpsertedFunctions, f.ID)
+	}
+
+	return
+}
+
+// readClass reads the class from either the class file or the jar file.
+// When both the class file and the binary name are given, they must match.
+func readClass(classFile string, jarFile string, binaryName string) (c classfile.ClassFile, err error) {
+	if jarFile != "" {
+		return classfile.ReadFromJar(jarFile, binaryName)
+	}
+
+	if c, err = classfile.ReadFile(classFile); err != nil {
+		return
+	}
+
+	if binaryName != "" && binaryName != c.BinaryName {
+		err = fmt.Errorf("%s defines %s rather than %s", classFile, c.BinaryName, binaryName)
+	}
+
+	return
+}
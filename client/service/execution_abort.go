@@ -0,0 +1,59 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	clientError "github.com/scalar-labs/scalardl-go-client-sdk/v3/client/error"
+	"github.com/scalar-labs/scalardl-go-client-sdk/v3/crypto"
+	"github.com/scalar-labs/scalardl-go-client-sdk/v3/ledger/model"
+	"github.com/scalar-labs/scalardl-go-client-sdk/v3/ledger/statuscode"
+	"github.com/scalar-labs/scalardl-go-client-sdk/v3/rpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// AbortExecution aborts the contract execution of the nonce in Ledger, e.g. after its response is lost,
+// and returns the state of the execution, which is COMMITTED if it has been committed already.
+func (s ClientService) AbortExecution(nonce string) (state model.TransactionState, err error) {
+	var conns = s.acquire()
+	defer conns.release()
+
+	if conns.config.ClientMode != "CLIENT" {
+		return state, clientError.NewClientError(statuscode.InvalidRequest, "wrong mode specified")
+	}
+
+	if nonce == "" {
+		return state, fmt.Errorf("nonce cannot be empty")
+	}
+
+	var key crypto.KeyringEntry
+	if key, err = s.currentKey(); err != nil {
+		return
+	}
+
+	var (
+		trailer metadata.MD
+		request = &rpc.ExecutionAbortRequest{
+			Nonce:        nonce,
+			CertHolderId: s.identity.CertHolderID,
+			CertVersion:  uint32(key.Version),
+		}
+		response *rpc.ExecutionAbortResponse
+	)
+
+	if err = request.SignWith(key.Signer); err != nil {
+		return
+	}
+
+	var ledger = rpc.NewLedgerClient(conns.ledger)
+	if response, err = ledger.AbortExecution(context.Background(), request, grpc.Trailer(&trailer)); err != nil {
+		if trailer.Len() > 0 {
+			err = getClientErrorFromTrailer(trailer)
+		}
+
+		return
+	}
+
+	return model.TransactionState(response.GetState()), nil
+}
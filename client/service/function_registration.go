@@ -0,0 +1,80 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/scalar-labs/scalardl-go-client-sdk/v3/classfile"
+	clientError "github.com/scalar-labs/scalardl-go-client-sdk/v3/client/error"
+	"github.com/scalar-labs/scalardl-go-client-sdk/v3/ledger/statuscode"
+	"github.com/scalar-labs/scalardl-go-client-sdk/v3/rpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// RegisterFunction registers function to Scalar DL networks.
+func (s ClientService) RegisterFunction(
+	id string,
+	name string,
+	functionBytes []byte,
+) (err error) {
+	var conns = s.acquire()
+	defer conns.release()
+
+	if conns.config.ClientMode != "CLIENT" {
+		return clientError.NewClientError(statuscode.InvalidRequest, "wrong mode specified")
+	}
+
+	if id == "" {
+		return fmt.Errorf("id cannot be empty")
+	}
+
+	if name == "" {
+		return fmt.Errorf("name cannot be empty")
+	}
+
+	if functionBytes == nil {
+		return fmt.Errorf("functionBytes cannot be nil")
+	}
+
+	var (
+		trailer metadata.MD
+		request = &rpc.FunctionRegistrationRequest{
+			FunctionId:         id,
+			FunctionBinaryName: name,
+			FunctionByteCode:   functionBytes,
+		}
+		privileged = rpc.NewLedgerPrivilegedClient(conns.ledgerPrivileged)
+	)
+
+	if _, err = privileged.RegisterFunction(context.Background(), request, grpc.Trailer(&trailer)); err != nil {
+		if trailer.Len() > 0 {
+			err = getClientErrorFromTrailer(trailer)
+		}
+	}
+
+	return
+}
+
+// RegisterFunctionFromClassFile registers the Java class file at the given path as a function.
+// The binary name of the function is derived from the class file.
+func (s ClientService) RegisterFunctionFromClassFile(id string, path string) (err error) {
+	var c classfile.ClassFile
+
+	if c, err = classfile.ReadFile(path); err != nil {
+		return
+	}
+
+	return s.RegisterFunction(id, c.BinaryName, c.Bytes)
+}
+
+// RegisterFunctionFromJar registers the class of the given binary name in the jar file at the given path as a function.
+func (s ClientService) RegisterFunctionFromJar(id string, path string, name string) (err error) {
+	var c classfile.ClassFile
+
+	if c, err = classfile.ReadFromJar(path, name); err != nil {
+		return
+	}
+
+	return s.RegisterFunction(id, c.BinaryName, c.Bytes)
+}
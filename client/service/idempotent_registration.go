@@ -0,0 +1,183 @@
+package service
+
+import (
+	"encoding/base64"
+	"fmt"
+
+	"github.com/scalar-labs/scalardl-go-client-sdk/v3/json"
+	"github.com/scalar-labs/scalardl-go-client-sdk/v3/ledger/model"
+	"github.com/scalar-labs/scalardl-go-client-sdk/v3/ledger/statuscode"
+	"github.com/scalar-labs/scalardl-go-client-sdk/v3/rpc"
+)
+
+// EnsureCertificate registers the certificate in the client config, and succeeds if it is already registered.
+// A certificate registered only in one of Ledger and Auditor is registered in the other one.
+// For an already registered certificate, it checks if Ledger accepts the requests signed by the private key in the client config,
+// and returns an error if the registered certificate doesn't match the private key.
+func (s ClientService) EnsureCertificate() (err error) {
+	_, err = s.ensureCertificate()
+	return
+}
+
+// EnsureContract registers the contract, and succeeds if it is already registered.
+// For an already registered contract, it checks with ListContracts if the registered binary name,
+// byte code and properties are the same as the given ones, and returns an error if they are different.
+// The byte code is checked by verifying the registered signature with the certificate versions in the keyring.
+func (s ClientService) EnsureContract(
+	id string,
+	name string,
+	contractBytes []byte,
+	properties json.Object,
+) (err error) {
+	_, err = s.ensureContract(id, name, contractBytes, properties)
+	return
+}
+
+// ensureCertificate reports true if the certificate is newly registered in any of Ledger and Auditor.
+func (s ClientService) ensureCertificate() (registered bool, err error) {
+	var result model.CertificateRegistrationResult
+
+	if result, err = s.RegisterCertificateWithResult(); err != nil {
+		return
+	}
+
+	if !result.IsComplete() {
+		if result.Ledger.Status == model.Failed {
+			return false, result.Ledger.Err
+		}
+
+		return false, result.Auditor.Err
+	}
+
+	if result.Ledger.Status != model.AlreadyRegistered {
+		return result.IsNewlyRegistered(), nil
+	}
+
+	// any signed request is rejected with InvalidSignature if the registered certificate doesn't match the private key.
+	if _, err = s.ListContracts(""); isStatusCode(err, statuscode.InvalidSignature) {
+		key, _ := s.currentKey()
+
+		return false, fmt.Errorf(
+			"certificate %s (version %d) is already registered, but it doesn't match the private key",
+			s.identity.CertHolderID,
+			key.Version,
+		)
+	}
+
+	return result.IsNewlyRegistered(), err
+}
+
+// ensureContract reports true if the contract is newly registered.
+func (s ClientService) ensureContract(
+	id string,
+	name string,
+	contractBytes []byte,
+	properties json.Object,
+) (registered bool, err error) {
+	if err = s.RegisterContract(id, name, contractBytes, properties); err == nil {
+		return true, nil
+	}
+
+	if !isStatusCode(err, statuscode.ContractAlreadyRegistered) {
+		return
+	}
+
+	var contracts json.Object
+
+	if contracts, err = s.ListContracts(id); err != nil {
+		return
+	}
+
+	entry, ok := contracts[id].(map[string]interface{})
+	if !ok {
+		return false, fmt.Errorf("contract %s is already registered, but it is not listed", id)
+	}
+
+	return false, s.checkRegisteredContract(id, name, contractBytes, properties, entry)
+}
+
+// checkRegisteredContract compares a contract entry of ListContracts with the intended one.
+// The fields missing in the entry are not checked.
+func (s ClientService) checkRegisteredContract(
+	id string,
+	name string,
+	contractBytes []byte,
+	properties json.Object,
+	entry json.Object,
+) error {
+	for _, key := range []string{"contract_binary_name", "contract_name"} {
+		if registeredName, ok := entry[key].(string); ok && registeredName != name {
+			return fmt.Errorf("contract %s is already registered as %s rather than %s", id, registeredName, name)
+		}
+	}
+
+	var request = &rpc.ContractRegistrationRequest{
+		ContractId:         id,
+		ContractBinaryName: name,
+		ContractByteCode:   contractBytes,
+		CertHolderId:       s.identity.CertHolderID,
+	}
+
+	if properties != nil {
+		request.ContractProperties = properties.String()
+	}
+
+	if registered, ok := entry["contract_properties"]; ok && !equalProperties(registered, properties) {
+		return fmt.Errorf("contract %s is already registered with different properties", id)
+	}
+
+	signature, ok := entry["signature"].(string)
+	if !ok {
+		return nil
+	}
+
+	var err error
+
+	if request.Signature, err = base64.StdEncoding.DecodeString(signature); err != nil {
+		return fmt.Errorf("the signature of contract %s is not base64: %w", id, err)
+	}
+
+	// the contract can be registered with any version in the keyring before rotations.
+	for _, version := range s.identity.Keyring.Versions() {
+		if key, ok := s.identity.Keyring.Get(version); ok && key.Verifier != nil {
+			request.CertVersion = uint32(version)
+
+			if request.VerifyWith(key.Verifier) {
+				return nil
+			}
+		}
+	}
+
+	return fmt.Errorf("contract %s is already registered with a different byte code or properties", id)
+}
+
+// equalProperties compares the contract properties from ListContracts,
+// which can be either a JSON object or a JSON string, with the intended ones.
+func equalProperties(registered interface{}, properties json.Object) bool {
+	switch p := registered.(type) {
+	case nil:
+		return len(properties) == 0
+	case string:
+		if p == "" {
+			return len(properties) == 0
+		}
+
+		parsed, err := json.FromJSON(p)
+		return err == nil && parsed.Equal(normalize(properties))
+	case map[string]interface{}:
+		return json.Object(p).Equal(normalize(properties))
+	default:
+		return false
+	}
+}
+
+// normalize makes the values of json.Object as same as the ones parsed from JSON.
+func normalize(o json.Object) json.Object {
+	if o == nil {
+		return json.Object{}
+	}
+
+	normalized, _ := json.FromJSON(o.String())
+
+	return normalized
+}
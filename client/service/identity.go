@@ -0,0 +1,87 @@
+package service
+
+import (
+	"fmt"
+
+	"github.com/scalar-labs/scalardl-go-client-sdk/v3/client/config"
+	"github.com/scalar-labs/scalardl-go-client-sdk/v3/crypto"
+)
+
+// Identity defines on behalf of whom ClientService sends requests:
+// a certificate holder and the keyring of its certificate versions.
+// For HMAC authentication, CertHolderID is the entity ID and the keyring holds the secret key versions.
+type Identity struct {
+	CertHolderID string
+	Keyring      *crypto.Keyring
+}
+
+// NewIdentity creates Identity with the given certificate and private key as the first version in the keyring.
+func NewIdentity(certHolderID string, certVersion int, cert string, privateKey string) (identity Identity, err error) {
+	if certHolderID == "" {
+		return identity, fmt.Errorf("certHolderID cannot be empty")
+	}
+
+	identity.CertHolderID = certHolderID
+	identity.Keyring = crypto.NewKeyring()
+
+	err = identity.Keyring.Add(certVersion, cert, privateKey)
+
+	return
+}
+
+// NewHmacIdentity creates Identity of the given entity with the secret key for HMAC authentication
+// as the first version in the keyring.
+func NewHmacIdentity(entityID string, secretVersion int, secretKey string) (identity Identity, err error) {
+	if entityID == "" {
+		return identity, fmt.Errorf("entityID cannot be empty")
+	}
+
+	identity.CertHolderID = entityID
+	identity.Keyring = crypto.NewKeyring()
+
+	err = identity.Keyring.AddSecret(secretVersion, secretKey)
+
+	return
+}
+
+// NewIdentityFromConfig creates Identity with the certificate and the private key, or the secret key for HMAC authentication,
+// in the client config.
+func NewIdentityFromConfig(c config.ClientConfig) (Identity, error) {
+	if c.AuthenticationMethod == config.AuthenticationMethodHMAC {
+		return NewHmacIdentity(c.CertHolderID, c.SecretVersion, c.SecretKey)
+	}
+
+	return NewIdentity(c.CertHolderID, c.CertVersion, c.Cert, c.PrivateKey)
+}
+
+// Identity returns the identity that the service sends requests on behalf of.
+func (s ClientService) Identity() Identity {
+	return s.identity
+}
+
+// WithIdentity returns a copy of the service that sends requests on behalf of the given identity.
+// The copy shares the connections to Ledger and Auditor with the service,
+// so many identities can be served from one process by selecting one of them per call, e.g.
+//
+//	service.WithIdentity(alice).ExecuteContract(...)
+//
+// Closing any of the copies closes the shared connections.
+func (s ClientService) WithIdentity(identity Identity) ClientService {
+	s.identity = identity
+	return s
+}
+
+// currentKey returns the current certificate version of the identity and its signer.
+func (s ClientService) currentKey() (key crypto.KeyringEntry, err error) {
+	var ok bool
+
+	if s.identity.Keyring == nil {
+		return key, fmt.Errorf("no keyring is in the identity")
+	}
+
+	if key, ok = s.identity.Keyring.Current(); !ok {
+		return key, fmt.Errorf("no certificate is in the keyring")
+	}
+
+	return
+}
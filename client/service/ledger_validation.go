@@ -20,16 +20,14 @@ import (
 const JavaMaxIntValue = 2147483647
 
 // ValidateLedger validates the specified asset between the specified ages.
+// The arguments are the asset ID, and optionally the start age and the end age.
+//
+// Deprecated: Use ValidateAsset or ValidateLedgerRange instead, which are checked at compile time.
 func (s ClientService) ValidateLedger(args ...interface{}) (result model.LedgerValidationResult, err error) {
-	if s.clientConfig.ClientMode != "CLIENT" {
-		return result, clientError.NewClientError(statuscode.InvalidRequest, "wrong mode specified")
-	}
-
 	var (
 		assetID  string
 		startAge int = 0
 		endAge   int = JavaMaxIntValue
-		signer   crypto.Signer
 		ok       bool
 	)
 
@@ -40,17 +38,40 @@ func (s ClientService) ValidateLedger(args ...interface{}) (result model.LedgerV
 	}
 
 	if len(args) > 1 {
-		if startAge, ok = args[1].(int); !ok {
+		if startAge, ok = toAge(args[1]); !ok {
 			return result, fmt.Errorf("startAge must be an integer")
 		}
 	}
 
 	if len(args) > 2 {
-		if endAge, ok = args[2].(int); !ok {
+		if endAge, ok = toAge(args[2]); !ok {
 			return result, fmt.Errorf("endAge must be an integer")
 		}
 	}
 
+	return s.ValidateLedgerRange(assetID, startAge, endAge)
+}
+
+// ValidateAsset validates all the ages of the specified asset.
+func (s ClientService) ValidateAsset(assetID string) (result model.LedgerValidationResult, err error) {
+	return s.ValidateLedgerRange(assetID, 0, JavaMaxIntValue)
+}
+
+// ValidateLedgerRange validates the specified asset between the specified ages.
+func (s ClientService) ValidateLedgerRange(
+	assetID string,
+	startAge int,
+	endAge int,
+) (result model.LedgerValidationResult, err error) {
+	var conns = s.acquire()
+	defer conns.release()
+
+	if conns.config.ClientMode != "CLIENT" {
+		return result, clientError.NewClientError(statuscode.InvalidRequest, "wrong mode specified")
+	}
+
+	var key crypto.KeyringEntry
+
 	if assetID == "" {
 		return result, fmt.Errorf("assetID cannot be empty")
 	}
@@ -59,11 +80,11 @@ func (s ClientService) ValidateLedger(args ...interface{}) (result model.LedgerV
 		return result, fmt.Errorf("invalid ages specified")
 	}
 
-	if signer, err = crypto.NewEcdsaSha256Signer([]byte(s.clientConfig.PrivateKey)); err != nil {
+	if key, err = s.currentKey(); err != nil {
 		return
 	}
 
-	if s.clientConfig.IsAuditorEnabled && s.clientConfig.IsAuditorLinearizableValidationEnabled {
+	if conns.config.IsAuditorEnabled && conns.config.IsAuditorLinearizableValidationEnabled {
 		argument := json.Object{
 			"asset_id": assetID,
 		}
@@ -74,7 +95,7 @@ func (s ClientService) ValidateLedger(args ...interface{}) (result model.LedgerV
 		var executed model.ContractExecutionResult
 
 		if executed, err = s.ExecuteContract(
-			s.clientConfig.AuditorLinearizableValidationContractID,
+			conns.config.AuditorLinearizableValidationContractID,
 			argument,
 			nil,
 		); err != nil {
@@ -95,11 +116,11 @@ func (s ClientService) ValidateLedger(args ...interface{}) (result model.LedgerV
 			AssetId:      assetID,
 			StartAge:     uint32(startAge),
 			EndAge:       uint32(endAge),
-			CertHolderId: s.clientConfig.CertHolderID,
-			CertVersion:  uint32(s.clientConfig.CertVersion),
+			CertHolderId: s.identity.CertHolderID,
+			CertVersion:  uint32(key.Version),
 		}
 
-		if err = request.SignWith(signer); err != nil {
+		if err = request.SignWith(key.Signer); err != nil {
 			return
 		}
 
@@ -118,11 +139,11 @@ func (s ClientService) ValidateLedger(args ...interface{}) (result model.LedgerV
 		defer close(auditorErrorChan)
 
 		go func(r chan *rpc.LedgerValidationResponse, e chan error) {
-			if !s.clientConfig.IsAuditorEnabled {
+			if !conns.config.IsAuditorEnabled {
 				r <- nil
 				e <- nil
 			} else {
-				auditor := rpc.NewAuditorClient(s.auditorConnection)
+				auditor := rpc.NewAuditorClient(conns.auditor)
 				trailer := metadata.MD{}
 
 				response, err := auditor.ValidateLedger(context.Background(), request, grpc.Trailer(&trailer))
@@ -137,7 +158,7 @@ func (s ClientService) ValidateLedger(args ...interface{}) (result model.LedgerV
 		}(auditorChan, auditorErrorChan)
 
 		go func(r chan *rpc.LedgerValidationResponse, e chan error) {
-			ledger := rpc.NewLedgerClient(s.ledgerConnection)
+			ledger := rpc.NewLedgerClient(conns.ledger)
 			trailer := metadata.MD{}
 
 			response, err := ledger.ValidateLedger(context.Background(), request, grpc.Trailer(&trailer))
@@ -154,7 +175,7 @@ func (s ClientService) ValidateLedger(args ...interface{}) (result model.LedgerV
 		responseFromLedger, errFromLedger = <-ledgerChan, <-ledgerErrorChan
 
 		var errFromAuditor error
-		responseFromAuditor, err = <-auditorChan, <-auditorErrorChan
+		responseFromAuditor, errFromAuditor = <-auditorChan, <-auditorErrorChan
 
 		if errFromLedger != nil {
 			err = errFromLedger
@@ -166,7 +187,7 @@ func (s ClientService) ValidateLedger(args ...interface{}) (result model.LedgerV
 			return
 		}
 
-		if !s.clientConfig.IsAuditorEnabled {
+		if !conns.config.IsAuditorEnabled {
 			result.Code = statuscode.StatusCode(responseFromLedger.StatusCode)
 			result.Proof = asset.FromGRPC(responseFromLedger.Proof)
 		} else {
@@ -192,3 +213,43 @@ func (s ClientService) ValidateLedger(args ...interface{}) (result model.LedgerV
 
 	return
 }
+
+// toAge converts an integer of any type to an age, and reports false only if it is not an integer.
+// An integer out of the range of ages results in -1, so that ValidateLedgerRange rejects it as it rejects the other invalid ages.
+func toAge(v interface{}) (age int, ok bool) {
+	var i int64
+
+	switch n := v.(type) {
+	case int:
+		i = int64(n)
+	case int8:
+		i = int64(n)
+	case int16:
+		i = int64(n)
+	case int32:
+		i = int64(n)
+	case int64:
+		i = n
+	case uint:
+		i = int64(n)
+	case uint8:
+		i = int64(n)
+	case uint16:
+		i = int64(n)
+	case uint32:
+		i = int64(n)
+	case uint64:
+		if n > JavaMaxIntValue {
+			return -1, true
+		}
+		i = int64(n)
+	default:
+		return 0, false
+	}
+
+	if i < 0 || i > JavaMaxIntValue {
+		return -1, true
+	}
+
+	return int(i), true
+}
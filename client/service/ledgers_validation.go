@@ -0,0 +1,135 @@
+package service
+
+import (
+	"fmt"
+	"sync"
+
+	clientError "github.com/scalar-labs/scalardl-go-client-sdk/v3/client/error"
+	"github.com/scalar-labs/scalardl-go-client-sdk/v3/ledger/model"
+	"github.com/scalar-labs/scalardl-go-client-sdk/v3/ledger/statuscode"
+)
+
+// DefaultLedgersValidationConcurrency is the number of assets validated concurrently if it is not specified.
+const DefaultLedgersValidationConcurrency = 8
+
+// AgeRange defines the ages of an asset to validate.
+type AgeRange struct {
+	StartAge int
+	EndAge   int
+}
+
+// LedgersValidationOptions defines the optional parameters of ValidateLedgers.
+type LedgersValidationOptions struct {
+	// Concurrency is the maximum number of assets validated at the same time.
+	// DefaultLedgersValidationConcurrency is used if it is not positive.
+	Concurrency int
+
+	// AgeRanges specifies the ages to validate for each asset ID.
+	// All the ages are validated for the assets not in it.
+	AgeRanges map[string]AgeRange
+
+	// Progress is called each time an asset is validated.
+	// The calls are serialized, so it doesn't have to be goroutine-safe.
+	Progress func(progress LedgersValidationProgress)
+}
+
+// LedgersValidationProgress reports the validation of an asset while ValidateLedgers is running.
+type LedgersValidationProgress struct {
+	AssetID   string
+	Result    model.LedgerValidationResult
+	Err       error
+	Completed int
+	Total     int
+}
+
+// ValidateLedgers validates the specified assets concurrently and summarizes the results in a report.
+// The failure of an asset doesn't stop the others from being validated,
+// and it is listed in the report rather than returned as an error.
+func (s ClientService) ValidateLedgers(
+	assetIDs []string,
+	options LedgersValidationOptions,
+) (report model.LedgersValidationReport, err error) {
+	var conns = s.acquire()
+	defer conns.release()
+
+	if conns.config.ClientMode != "CLIENT" {
+		return report, clientError.NewClientError(statuscode.InvalidRequest, "wrong mode specified")
+	}
+
+	for _, id := range assetIDs {
+		if id == "" {
+			return report, fmt.Errorf("assetID cannot be empty")
+		}
+	}
+
+	var concurrency = options.Concurrency
+	if concurrency <= 0 {
+		concurrency = DefaultLedgersValidationConcurrency
+	}
+
+	var (
+		results   = make([]model.LedgerValidationResult, len(assetIDs))
+		errs      = make([]error, len(assetIDs))
+		semaphore = make(chan struct{}, concurrency)
+		wg        sync.WaitGroup
+		mutex     sync.Mutex
+		completed int
+	)
+
+	for i, id := range assetIDs {
+		wg.Add(1)
+		semaphore <- struct{}{}
+
+		go func(i int, id string) {
+			defer wg.Done()
+			defer func() { <-semaphore }()
+
+			var ages = AgeRange{StartAge: 0, EndAge: JavaMaxIntValue}
+			if r, ok := options.AgeRanges[id]; ok {
+				ages = r
+			}
+
+			results[i], errs[i] = s.ValidateLedgerRange(id, ages.StartAge, ages.EndAge)
+
+			mutex.Lock()
+			defer mutex.Unlock()
+
+			completed++
+
+			if options.Progress != nil {
+				options.Progress(LedgersValidationProgress{
+					AssetID:   id,
+					Result:    results[i],
+					Err:       errs[i],
+					Completed: completed,
+					Total:     len(assetIDs),
+				})
+			}
+		}(i, id)
+	}
+
+	wg.Wait()
+
+	for i, id := range assetIDs {
+		if errs[i] == nil && results[i].Code == statuscode.OK {
+			report.OK = append(report.OK, id)
+			continue
+		}
+
+		var failure = model.LedgerValidationFailure{
+			AssetID:      id,
+			Code:         results[i].Code,
+			Proof:        results[i].Proof,
+			AuditorProof: results[i].AuditorProof,
+			Err:          errs[i],
+		}
+
+		if e, ok := errs[i].(clientError.ClientError); ok {
+			failure.Code = e.StatusCode()
+		}
+
+		report.Failures = append(report.Failures, failure)
+	}
+
+	return
+}
@@ -0,0 +1,465 @@
+// Package mock provides a programmable implementation of service.Client for unit tests.
+//
+//	client := mock.NewClient(t)
+//	client.On("ExecuteContract", "transfer", json.Object{"amount": 100}, mock.Any).
+//		Return(model.ContractExecutionResult{Result: json.Object{"balance": 900}})
+//	client.On("ValidateAsset", "alice").Fail(statuscode.InvalidHash, "tampered")
+//
+//	... // the code under test calls client
+//
+//	client.Verify()
+package mock
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+
+	clientError "github.com/scalar-labs/scalardl-go-client-sdk/v3/client/error"
+	"github.com/scalar-labs/scalardl-go-client-sdk/v3/client/manifest"
+	"github.com/scalar-labs/scalardl-go-client-sdk/v3/client/service"
+	"github.com/scalar-labs/scalardl-go-client-sdk/v3/json"
+	"github.com/scalar-labs/scalardl-go-client-sdk/v3/ledger/asset"
+	"github.com/scalar-labs/scalardl-go-client-sdk/v3/ledger/model"
+	"github.com/scalar-labs/scalardl-go-client-sdk/v3/ledger/statuscode"
+)
+
+// Any matches any value of an argument.
+const Any = anyValue("mock.Any")
+
+type anyValue string
+
+// TestingT is the part of testing.T that the mock reports the unexpected calls and the unmet expectations to.
+type TestingT interface {
+	Helper()
+	Errorf(format string, args ...interface{})
+}
+
+// Call is a method call received by the mock.
+type Call struct {
+	Method string
+	Args   []interface{}
+}
+
+// Expectation defines the arguments of a method call that the mock expects, and what it returns for the call.
+// Without Return, the call returns the zero values without an error.
+type Expectation struct {
+	client *Client
+	method string
+	args   []interface{}
+	times  int
+	value  interface{}
+	err    error
+	called int
+}
+
+// Return sets the value that the method returns, e.g. model.ContractExecutionResult for ExecuteContract.
+// A value of another type, or any value for the methods that return only an error, is reported to TestingT.
+func (e *Expectation) Return(value interface{}) *Expectation {
+	e.client.t.Helper()
+
+	if expected, ok := returnTypes[e.method]; !ok {
+		e.client.t.Errorf("mock: %s doesn't return a value", e.method)
+	} else if reflect.TypeOf(value) != expected {
+		e.client.t.Errorf("mock: %s returns %s rather than %T", e.method, expected, value)
+	}
+
+	e.value = value
+	return e
+}
+
+// ReturnError sets the error that the method returns.
+func (e *Expectation) ReturnError(err error) *Expectation {
+	e.err = err
+	return e
+}
+
+// Fail makes the method return ClientError with the status code and the message.
+func (e *Expectation) Fail(code statuscode.StatusCode, message string) *Expectation {
+	e.err = clientError.NewClientError(code, message)
+	return e
+}
+
+// Times limits the expectation to the number of calls, which are all required by Verify.
+// Without Times, the expectation matches any number of calls, and Verify requires at least one of them.
+func (e *Expectation) Times(n int) *Expectation {
+	e.times = n
+	return e
+}
+
+// returnTypes are the types of the values returned by the methods along with an error.
+var returnTypes = map[string]reflect.Type{
+	"RegisterCertificateWithResult": reflect.TypeOf(model.CertificateRegistrationResult{}),
+	"RegisterCertificateVersion":    reflect.TypeOf(model.CertificateRegistrationResult{}),
+	"RotateCertificate":             reflect.TypeOf(model.CertificateRegistrationResult{}),
+	"RegisterSecretWithResult":      reflect.TypeOf(model.CertificateRegistrationResult{}),
+	"ListContracts":                 reflect.TypeOf(json.Object{}),
+	"Deploy":                        reflect.TypeOf(model.DeploymentReport{}),
+	"ExecuteContract":               reflect.TypeOf(model.ContractExecutionResult{}),
+	"ExecuteContractWithOptions":    reflect.TypeOf(model.ContractExecutionResult{}),
+	"ValidateAsset":                 reflect.TypeOf(model.LedgerValidationResult{}),
+	"ValidateLedgerRange":           reflect.TypeOf(model.LedgerValidationResult{}),
+	"ValidateLedgers":               reflect.TypeOf(model.LedgersValidationReport{}),
+	"RetrieveAssetProof":            reflect.TypeOf(asset.Proof{}),
+	"AbortExecution":                reflect.TypeOf(model.TransactionState(0)),
+}
+
+// Client implements service.Client by the expectations.
+// A call is matched with the expectations in the order they are added,
+// and the first one with the same method and arguments that is not used up handles it.
+// The json.Object arguments are compared by their JSON text, so 10 and 10.0 are the same.
+type Client struct {
+	t TestingT
+
+	mutex        sync.Mutex
+	expectations []*Expectation
+	calls        []Call
+}
+
+var _ service.Client = (*Client)(nil)
+
+// NewClient creates a mock without any expectation.
+func NewClient(t TestingT) *Client {
+	return &Client{t: t}
+}
+
+// On adds an expectation of the call of the method with the arguments.
+// The arguments are the ones of the method. Any matches any value, and nil matches a nil json.Object.
+// The options are matched field by field except the functions, e.g. LedgersValidationOptions.Progress.
+// The arguments after the given ones are not checked.
+func (c *Client) On(method string, args ...interface{}) *Expectation {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	var e = &Expectation{client: c, method: method, args: args}
+	c.expectations = append(c.expectations, e)
+
+	return e
+}
+
+// Calls returns the method calls received by the mock in order.
+func (c *Client) Calls() []Call {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	return append([]Call(nil), c.calls...)
+}
+
+// Verify reports the expectations that are not met.
+func (c *Client) Verify() {
+	c.t.Helper()
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	for _, e := range c.expectations {
+		switch {
+		case e.times > 0 && e.called < e.times:
+			c.t.Errorf("mock: %s is expected %d times, but called %d times", describe(e.method, e.args), e.times, e.called)
+		case e.times == 0 && e.called == 0:
+			c.t.Errorf("mock: %s is expected, but not called", describe(e.method, e.args))
+		}
+	}
+}
+
+// call finds the expectation of the call, and reports an unexpected call to TestingT.
+func (c *Client) call(method string, args ...interface{}) (value interface{}, err error) {
+	c.t.Helper()
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.calls = append(c.calls, Call{Method: method, Args: args})
+
+	for _, e := range c.expectations {
+		if e.method != method || (e.times > 0 && e.called >= e.times) || !matches(e.args, args) {
+			continue
+		}
+
+		e.called++
+
+		return e.value, e.err
+	}
+
+	c.t.Errorf("mock: unexpected call %s", describe(method, args))
+
+	return nil, fmt.Errorf("mock: unexpected call %s", describe(method, args))
+}
+
+// matches checks if the arguments of a call match the expected ones.
+func matches(expected []interface{}, actual []interface{}) bool {
+	if len(expected) > len(actual) {
+		return false
+	}
+
+	for i, e := range expected {
+		if !match(e, actual[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// match checks if an argument of a call matches the expected one.
+// Structs like the options are compared field by field, skipping the functions, which cannot be compared.
+func match(expected interface{}, actual interface{}) bool {
+	if expected == Any {
+		return true
+	}
+
+	if expected == nil {
+		return isNil(actual)
+	}
+
+	if o, ok := expected.(json.Object); ok {
+		a, ok := actual.(json.Object)
+		return ok && (o == nil) == (a == nil) && o.String() == a.String()
+	}
+
+	var e, a = reflect.ValueOf(expected), reflect.ValueOf(actual)
+
+	if e.Kind() != reflect.Struct || e.Type() != a.Type() {
+		return reflect.DeepEqual(expected, actual)
+	}
+
+	for i := 0; i < e.NumField(); i++ {
+		var field = e.Type().Field(i)
+
+		if field.PkgPath != "" {
+			return reflect.DeepEqual(expected, actual)
+		}
+
+		if field.Type.Kind() == reflect.Func {
+			continue
+		}
+
+		if !match(e.Field(i).Interface(), a.Field(i).Interface()) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// isNil checks if the value is nil, or a nil map, slice or pointer like json.Object(nil).
+func isNil(value interface{}) bool {
+	if value == nil {
+		return true
+	}
+
+	switch v := reflect.ValueOf(value); v.Kind() {
+	case reflect.Map, reflect.Slice, reflect.Ptr, reflect.Func, reflect.Interface:
+		return v.IsNil()
+	default:
+		return false
+	}
+}
+
+// describe formats a call for the reports.
+func describe(method string, args []interface{}) string {
+	var formatted []string
+
+	for _, a := range args {
+		if o, ok := a.(json.Object); ok && o != nil {
+			formatted = append(formatted, o.String())
+		} else {
+			formatted = append(formatted, fmt.Sprintf("%v", a))
+		}
+	}
+
+	return fmt.Sprintf("%s(%s)", method, strings.Join(formatted, ", "))
+}
+
+// RegisterCertificate implements service.Client.
+func (c *Client) RegisterCertificate() error {
+	_, err := c.call("RegisterCertificate")
+	return err
+}
+
+// RegisterCertificateWithResult implements service.Client.
+func (c *Client) RegisterCertificateWithResult() (result model.CertificateRegistrationResult, err error) {
+	value, err := c.call("RegisterCertificateWithResult")
+	result, _ = value.(model.CertificateRegistrationResult)
+
+	return
+}
+
+// RegisterCertificateVersion implements service.Client.
+func (c *Client) RegisterCertificateVersion(version int) (result model.CertificateRegistrationResult, err error) {
+	value, err := c.call("RegisterCertificateVersion", version)
+	result, _ = value.(model.CertificateRegistrationResult)
+
+	return
+}
+
+// RotateCertificate implements service.Client.
+func (c *Client) RotateCertificate(
+	version int,
+	cert string,
+	privateKey string,
+) (result model.CertificateRegistrationResult, err error) {
+	value, err := c.call("RotateCertificate", version, cert, privateKey)
+	result, _ = value.(model.CertificateRegistrationResult)
+
+	return
+}
+
+// RegisterSecret implements service.Client.
+func (c *Client) RegisterSecret() error {
+	_, err := c.call("RegisterSecret")
+	return err
+}
+
+// RegisterSecretWithResult implements service.Client.
+func (c *Client) RegisterSecretWithResult() (result model.CertificateRegistrationResult, err error) {
+	value, err := c.call("RegisterSecretWithResult")
+	result, _ = value.(model.CertificateRegistrationResult)
+
+	return
+}
+
+// EnsureCertificate implements service.Client.
+func (c *Client) EnsureCertificate() error {
+	_, err := c.call("EnsureCertificate")
+	return err
+}
+
+// RegisterContract implements service.Client.
+func (c *Client) RegisterContract(id string, name string, contractBytes []byte, properties json.Object) error {
+	_, err := c.call("RegisterContract", id, name, contractBytes, properties)
+	return err
+}
+
+// RegisterContractFromClassFile implements service.Client.
+func (c *Client) RegisterContractFromClassFile(id string, path string, properties json.Object) error {
+	_, err := c.call("RegisterContractFromClassFile", id, path, properties)
+	return err
+}
+
+// RegisterContractFromJar implements service.Client.
+func (c *Client) RegisterContractFromJar(id string, path string, name string, properties json.Object) error {
+	_, err := c.call("RegisterContractFromJar", id, path, name, properties)
+	return err
+}
+
+// EnsureContract implements service.Client.
+func (c *Client) EnsureContract(id string, name string, contractBytes []byte, properties json.Object) error {
+	_, err := c.call("EnsureContract", id, name, contractBytes, properties)
+	return err
+}
+
+// ListContracts implements service.Client.
+func (c *Client) ListContracts(id string) (contracts json.Object, err error) {
+	value, err := c.call("ListContracts", id)
+	contracts, _ = value.(json.Object)
+
+	return
+}
+
+// RegisterFunction implements service.Client.
+func (c *Client) RegisterFunction(id string, name string, functionBytes []byte) error {
+	_, err := c.call("RegisterFunction", id, name, functionBytes)
+	return err
+}
+
+// RegisterFunctionFromClassFile implements service.Client.
+func (c *Client) RegisterFunctionFromClassFile(id string, path string) error {
+	_, err := c.call("RegisterFunctionFromClassFile", id, path)
+	return err
+}
+
+// RegisterFunctionFromJar implements service.Client.
+func (c *Client) RegisterFunctionFromJar(id string, path string, name string) error {
+	_, err := c.call("RegisterFunctionFromJar", id, path, name)
+	return err
+}
+
+// Deploy implements service.Client.
+func (c *Client) Deploy(m manifest.Manifest) (report model.DeploymentReport, err error) {
+	value, err := c.call("Deploy", m)
+	report, _ = value.(model.DeploymentReport)
+
+	return
+}
+
+// ExecuteContract implements service.Client.
+func (c *Client) ExecuteContract(
+	id string,
+	argument json.Object,
+	functionArgument json.Object,
+) (result model.ContractExecutionResult, err error) {
+	value, err := c.call("ExecuteContract", id, argument, functionArgument)
+	result, _ = value.(model.ContractExecutionResult)
+
+	return
+}
+
+// ExecuteContractWithOptions implements service.Client.
+func (c *Client) ExecuteContractWithOptions(
+	id string,
+	argument json.Object,
+	functionArgument json.Object,
+	options service.ContractExecutionOptions,
+) (result model.ContractExecutionResult, err error) {
+	value, err := c.call("ExecuteContractWithOptions", id, argument, functionArgument, options)
+	result, _ = value.(model.ContractExecutionResult)
+
+	return
+}
+
+// ValidateAsset implements service.Client.
+func (c *Client) ValidateAsset(assetID string) (result model.LedgerValidationResult, err error) {
+	value, err := c.call("ValidateAsset", assetID)
+	result, _ = value.(model.LedgerValidationResult)
+
+	return
+}
+
+// ValidateLedgerRange implements service.Client.
+func (c *Client) ValidateLedgerRange(
+	assetID string,
+	startAge int,
+	endAge int,
+) (result model.LedgerValidationResult, err error) {
+	value, err := c.call("ValidateLedgerRange", assetID, startAge, endAge)
+	result, _ = value.(model.LedgerValidationResult)
+
+	return
+}
+
+// ValidateLedgers implements service.Client.
+func (c *Client) ValidateLedgers(
+	assetIDs []string,
+	options service.LedgersValidationOptions,
+) (report model.LedgersValidationReport, err error) {
+	value, err := c.call("ValidateLedgers", assetIDs, options)
+	report, _ = value.(model.LedgersValidationReport)
+
+	return
+}
+
+// RetrieveAssetProof implements service.Client.
+func (c *Client) RetrieveAssetProof(assetID string, age int) (proof asset.Proof, err error) {
+	value, err := c.call("RetrieveAssetProof", assetID, age)
+	proof, _ = value.(asset.Proof)
+
+	return
+}
+
+// AbortExecution implements service.Client.
+func (c *Client) AbortExecution(nonce string) (state model.TransactionState, err error) {
+	value, err := c.call("AbortExecution", nonce)
+	state, _ = value.(model.TransactionState)
+
+	return
+}
+
+// Close implements service.Client. It is recorded, but doesn't need an expectation.
+func (c *Client) Close() {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.calls = append(c.calls, Call{Method: "Close"})
+}
@@ -0,0 +1,130 @@
+package mock
+
+import (
+	"fmt"
+	"testing"
+
+	clientError "github.com/scalar-labs/scalardl-go-client-sdk/v3/client/error"
+	"github.com/scalar-labs/scalardl-go-client-sdk/v3/client/service"
+	"github.com/scalar-labs/scalardl-go-client-sdk/v3/json"
+	"github.com/scalar-labs/scalardl-go-client-sdk/v3/ledger/model"
+	"github.com/scalar-labs/scalardl-go-client-sdk/v3/ledger/statuscode"
+)
+
+// recorder records the reports of the mock instead of failing the test.
+type recorder struct {
+	errors []string
+}
+
+func (r *recorder) Helper() {}
+
+func (r *recorder) Errorf(format string, args ...interface{}) {
+	r.errors = append(r.errors, fmt.Sprintf(format, args...))
+}
+
+// transfer is the code under test, which depends on service.Client.
+func transfer(client service.Client, amount int) (float64, error) {
+	result, err := client.ExecuteContract("transfer", json.Object{"amount": amount}, nil)
+	if err != nil {
+		return 0, err
+	}
+
+	balance, _ := result.Result["balance"].(float64)
+
+	return balance, nil
+}
+
+func TestClient(t *testing.T) {
+	var (
+		r      = &recorder{}
+		client = NewClient(r)
+	)
+
+	client.On("ExecuteContract", "transfer", json.Object{"amount": 100.0}, nil).
+		Return(model.ContractExecutionResult{Result: json.Object{"balance": 900.0}}).
+		Times(1)
+	client.On("ExecuteContract", "transfer", Any).Fail(statuscode.InvalidNonce, "used")
+
+	if balance, err := transfer(client, 100); err != nil || balance != 900 {
+		t.Errorf("should return the canned result: %v %v", balance, err)
+	}
+
+	_, err := transfer(client, 100)
+	if e, ok := err.(clientError.ClientError); !ok || e.StatusCode() != statuscode.InvalidNonce {
+		t.Errorf("should return the injected ClientError after the first expectation is used up: %v", err)
+	}
+
+	if len(client.Calls()) != 2 || client.Calls()[0].Method != "ExecuteContract" {
+		t.Errorf("should record the calls: %v", client.Calls())
+	}
+
+	client.Verify()
+
+	if len(r.errors) != 0 {
+		t.Errorf("should meet the expectations: %v", r.errors)
+	}
+}
+
+func TestClient_Unexpected(t *testing.T) {
+	var (
+		r      = &recorder{}
+		client = NewClient(r)
+	)
+
+	client.On("ValidateAsset", "alice").Return(model.LedgerValidationResult{Code: statuscode.OK})
+	client.On("RegisterCertificate").Times(2)
+
+	if _, err := client.ValidateAsset("bob"); err == nil {
+		t.Errorf("should fail for an unexpected call")
+	}
+
+	if err := client.RegisterCertificate(); err != nil {
+		t.Errorf("should return no error without Return: %v", err)
+	}
+
+	client.Verify()
+
+	if len(r.errors) != 3 {
+		t.Errorf("should report the unexpected call and the unmet expectations: %v", r.errors)
+	}
+
+	r.errors = nil
+	client.On("ValidateAsset", "carol").Return(model.ContractExecutionResult{})
+	client.On("RegisterContract", "id").Return(1)
+
+	if len(r.errors) != 2 {
+		t.Errorf("should report the return values of wrong types: %v", r.errors)
+	}
+}
+
+func TestClient_Options(t *testing.T) {
+	var (
+		r      = &recorder{}
+		client = NewClient(r)
+	)
+
+	client.On("ValidateLedgers", []string{"a"}, service.LedgersValidationOptions{Concurrency: 2}).
+		Return(model.LedgersValidationReport{}).
+		Times(1)
+
+	var options = service.LedgersValidationOptions{
+		Concurrency: 2,
+		Progress:    func(progress service.LedgersValidationProgress) {},
+	}
+
+	if _, err := client.ValidateLedgers([]string{"a"}, options); err != nil {
+		t.Errorf("should match the options with Progress: %v", err)
+	}
+
+	options.Concurrency = 3
+
+	if _, err := client.ValidateLedgers([]string{"a"}, options); err == nil {
+		t.Errorf("should not match the options of another concurrency")
+	}
+
+	client.Verify()
+
+	if len(r.errors) != 1 {
+		t.Errorf("should report only the unexpected call: %v", r.errors)
+	}
+}
@@ -0,0 +1,346 @@
+package service_test
+
+import (
+	"context"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/scalar-labs/scalardl-go-client-sdk/v3/client/manifest"
+	"github.com/scalar-labs/scalardl-go-client-sdk/v3/client/service"
+	"github.com/scalar-labs/scalardl-go-client-sdk/v3/crypto/keygen"
+	sdkJSON "github.com/scalar-labs/scalardl-go-client-sdk/v3/json"
+	"github.com/scalar-labs/scalardl-go-client-sdk/v3/ledger/model"
+	"github.com/scalar-labs/scalardl-go-client-sdk/v3/ledger/statuscode"
+	"github.com/scalar-labs/scalardl-go-client-sdk/v3/scalardltest"
+	"google.golang.org/grpc"
+)
+
+// methods returns the methods of the interactions recorded since the offset, with the requests of ExecuteContract.
+func methods(recorder *scalardltest.Recorder, offset int) (called []string, executions []map[string]interface{}) {
+	for _, i := range recorder.Interactions()[offset:] {
+		called = append(called, i.Method)
+
+		if i.Method == "/rpc.Ledger/ExecuteContract" {
+			var request map[string]interface{}
+			json.Unmarshal(i.Request, &request)
+			executions = append(executions, request)
+		}
+	}
+
+	return
+}
+
+func TestExecuteContractWithOptions(t *testing.T) {
+	var (
+		recorder = scalardltest.NewRecorder()
+		_, s     = scalardltest.NewTestClient(t, scalardltest.Options{Auditor: true}, recorder.DialOptions()...)
+		offset   = len(recorder.Interactions())
+	)
+
+	result, err := s.ExecuteContractWithOptions(
+		scalardltest.CounterID, sdkJSON.Object{"asset_id": "a", "amount": 10}, nil, service.ContractExecutionOptions{PreExecution: true},
+	)
+	if err != nil || result.Result["balance"] != 10.0 || len(result.Proofs) != 1 || len(result.AuditorProofs) != 0 {
+		t.Fatalf("should pre-execute the contract in Ledger only: %v %v", result, err)
+	}
+
+	called, executions := methods(recorder, offset)
+	if len(called) != 1 || called[0] != "/rpc.Ledger/ExecuteContract" || executions[0]["pre_execution"] != true {
+		t.Errorf("should not order or validate the pre-execution in Auditor: %v", called)
+	}
+
+	offset = len(recorder.Interactions())
+
+	result, err = s.ExecuteContractWithOptions(
+		scalardltest.CounterID, sdkJSON.Object{"asset_id": "a", "amount": 5}, nil, service.ContractExecutionOptions{OrderingKeys: []string{"a", "b"}},
+	)
+	if err != nil || result.Result["balance"] != 5.0 || len(result.AuditorProofs) != 1 {
+		t.Fatalf("should execute the contract without the states of the pre-execution: %v %v", result, err)
+	}
+
+	called, executions = methods(recorder, offset)
+	if len(called) != 3 || called[0] != "/rpc.Auditor/OrderExecution" || called[2] != "/rpc.Auditor/ValidateExecution" {
+		t.Errorf("should order and validate the execution in Auditor: %v", called)
+	}
+
+	if keys, _ := executions[0]["ordering_keys"].([]interface{}); len(keys) != 2 || keys[0] != "a" || keys[1] != "b" {
+		t.Errorf("should send the ordering keys in the request: %v", executions[0])
+	}
+
+	if _, ok := executions[0]["pre_execution"]; ok {
+		t.Errorf("should not be a pre-execution: %v", executions[0])
+	}
+}
+
+func TestRegisterCertificateWithResult(t *testing.T) {
+	server, err := scalardltest.NewServer(scalardltest.Options{Auditor: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer server.Close()
+
+	s, err := server.NewClientService("bob")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Close()
+
+	server.InjectError("/rpc.AuditorPrivileged/RegisterCert", statuscode.DatabaseError, "unavailable")
+
+	result, err := s.RegisterCertificateWithResult()
+	if err != nil || result.Auditor.Status != model.Failed || result.Ledger.Status != model.Registered {
+		t.Errorf("should register the certificate to Ledger even if it fails in Auditor: %+v %v", result, err)
+	}
+
+	if result.IsComplete() {
+		t.Errorf("should not be complete if the registration failed in Auditor")
+	}
+
+	result, err = s.RegisterCertificateWithResult()
+	if err != nil || result.Auditor.Status != model.Registered || result.Ledger.Status != model.AlreadyRegistered || !result.IsComplete() {
+		t.Errorf("should repair the certificate registered only in Ledger: %+v %v", result, err)
+	}
+
+	if s, err = server.NewClientService("carol"); err != nil {
+		t.Fatal(err)
+	}
+	defer s.Close()
+
+	server.InjectError("/rpc.LedgerPrivileged/RegisterCert", statuscode.DatabaseError, "unavailable")
+
+	if result, err = s.RegisterCertificateWithResult(); err != nil || result.Auditor.Status != model.Registered || result.Ledger.Status != model.Failed {
+		t.Errorf("should report the failure in Ledger: %+v %v", result, err)
+	}
+
+	result, err = s.RegisterCertificateWithResult()
+	if err != nil || result.Auditor.Status != model.AlreadyRegistered || result.Ledger.Status != model.Registered || !result.IsComplete() {
+		t.Errorf("should register the certificate to Ledger when it is already registered in Auditor: %+v %v", result, err)
+	}
+
+	if err = s.RegisterCertificate(); err == nil {
+		t.Errorf("should return the already registered error once registered in both")
+	}
+}
+
+func TestRotateCertificate(t *testing.T) {
+	var server, s = scalardltest.NewTestClient(t, scalardltest.Options{Auditor: true})
+
+	key, err := keygen.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cert, err := keygen.SelfSign(key, pkix.Name{CommonName: "alice"}, time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err = s.RotateCertificate(1, cert, key); err == nil {
+		t.Errorf("should not replace the current version with another certificate")
+	}
+
+	if current, _ := s.Keyring().Current(); current.Version != 1 || current.Cert == cert {
+		t.Errorf("should keep the current version: %d", current.Version)
+	}
+
+	server.InjectError("/rpc.AuditorPrivileged/RegisterCert", statuscode.DatabaseError, "unavailable")
+
+	if _, err = s.RotateCertificate(2, cert, key); err == nil {
+		t.Errorf("should fail if the registration is not complete")
+	}
+
+	if _, ok := s.Keyring().Get(2); ok {
+		t.Errorf("should remove the version whose registration failed")
+	}
+
+	server.ClearErrors()
+
+	if _, err = s.RotateCertificate(2, cert, key); err != nil {
+		t.Fatalf("should rotate the certificate on retry: %v", err)
+	}
+
+	if current, _ := s.Keyring().Current(); current.Version != 2 {
+		t.Errorf("should switch to the new version: %d", current.Version)
+	}
+
+	if _, err = s.ExecuteContract(scalardltest.CounterID, sdkJSON.Object{"asset_id": "a", "amount": 1}, nil); err != nil {
+		t.Errorf("should execute a contract with the new version: %v", err)
+	}
+}
+
+func TestEnsureContract(t *testing.T) {
+	var server, s = scalardltest.NewTestClient(t, scalardltest.Options{Auditor: true})
+
+	server.InjectError("/rpc.Ledger/RegisterContract", statuscode.DatabaseError, "unavailable")
+
+	if err := s.RegisterContract("half", scalardltest.CounterName, []byte{0xCA, 0xFE}, nil); err == nil {
+		t.Fatalf("should fail to register the contract in Ledger")
+	}
+
+	if err := s.EnsureContract("half", scalardltest.CounterName, []byte{0xCA, 0xFE}, nil); err != nil {
+		t.Fatalf("should repair the contract registered only in Auditor: %v", err)
+	}
+
+	if _, err := s.ExecuteContract("half", sdkJSON.Object{"asset_id": "a", "amount": 1}, nil); err != nil {
+		t.Errorf("should execute the repaired contract: %v", err)
+	}
+
+	if err := s.EnsureContract("half", scalardltest.CounterName, []byte{0xCA, 0xFE}, nil); err != nil {
+		t.Errorf("should succeed for the contract registered in both: %v", err)
+	}
+
+	if err := s.RegisterContract("half", scalardltest.CounterName, []byte{0xCA, 0xFE}, nil); err == nil {
+		t.Errorf("should report the contract registered in both as already registered")
+	}
+}
+
+func TestValidateLedger(t *testing.T) {
+	var _, s = scalardltest.NewTestClient(t, scalardltest.Options{})
+
+	if _, err := s.ExecuteContract(scalardltest.CounterID, sdkJSON.Object{"asset_id": "a", "amount": 1}, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	if validated, err := s.ValidateLedger("a", uint8(0), int64(0)); err != nil || validated.Code != statuscode.OK {
+		t.Errorf("should accept the ages of any integer type: %v %v", validated, err)
+	}
+
+	for _, ages := range [][]interface{}{{-1}, {0, -1}, {0, uint64(1) << 63}, {0, int64(service.JavaMaxIntValue) + 1}} {
+		if _, err := s.ValidateLedger(append([]interface{}{"a"}, ages...)...); err == nil || err.Error() != "invalid ages specified" {
+			t.Errorf("should reject the ages %v out of the range as invalid: %v", ages, err)
+		}
+	}
+
+	if _, err := s.ValidateLedger("a", "0"); err == nil || err.Error() != "startAge must be an integer" {
+		t.Errorf("should reject an age that is not an integer: %v", err)
+	}
+}
+
+func TestValidateLedgers(t *testing.T) {
+	var (
+		inFlight, maxInFlight int32
+		delay                 = func(
+			ctx context.Context, method string, request, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption,
+		) error {
+			if method == "/rpc.Ledger/ValidateLedger" {
+				var n = atomic.AddInt32(&inFlight, 1)
+				defer atomic.AddInt32(&inFlight, -1)
+
+				for m := atomic.LoadInt32(&maxInFlight); n > m && !atomic.CompareAndSwapInt32(&maxInFlight, m, n); {
+					m = atomic.LoadInt32(&maxInFlight)
+				}
+
+				time.Sleep(20 * time.Millisecond)
+			}
+
+			return invoker(ctx, method, request, reply, cc, opts...)
+		}
+		server, s = scalardltest.NewTestClient(t, scalardltest.Options{}, grpc.WithChainUnaryInterceptor(delay))
+	)
+
+	for _, id := range []string{"a", "b", "b", "c", "d"} {
+		if _, err := s.ExecuteContract(scalardltest.CounterID, sdkJSON.Object{"asset_id": id, "amount": 1}, nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	for _, id := range []string{"b", "c"} {
+		if err := server.TamperAsset(id, 0, sdkJSON.Object{"balance": 1000}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	var progress []service.LedgersValidationProgress
+
+	report, err := s.ValidateLedgers([]string{"a", "b", "c", "missing", "d"}, service.LedgersValidationOptions{
+		Concurrency: 2,
+		AgeRanges:   map[string]service.AgeRange{"b": {StartAge: 1, EndAge: 1}},
+		Progress:    func(p service.LedgersValidationProgress) { progress = append(progress, p) },
+	})
+	if err != nil {
+		t.Fatalf("should validate the assets: %v", err)
+	}
+
+	if maxInFlight != 2 {
+		t.Errorf("should validate the assets up to the concurrency at the same time: %d", maxInFlight)
+	}
+
+	if fmt.Sprint(report.OK) != "[a b d]" {
+		t.Errorf("should report the valid assets in order, excluding the tampered age out of the range: %v", report.OK)
+	}
+
+	if len(report.Failures) != 2 ||
+		report.Failures[0].AssetID != "c" || report.Failures[0].Code != statuscode.InvalidHash || report.Failures[0].Err != nil ||
+		report.Failures[1].AssetID != "missing" || report.Failures[1].Code != statuscode.AssetNotFound || report.Failures[1].Err == nil {
+		t.Errorf("should aggregate the failures without stopping the others: %v", report.Failures)
+	}
+
+	if len(progress) != 5 {
+		t.Fatalf("should report the progress of each asset: %v", progress)
+	}
+
+	for i, p := range progress {
+		if p.Completed != i+1 || p.Total != 5 {
+			t.Errorf("should count the completed assets: %v", p)
+		}
+
+		if p.AssetID == "b" && p.Result.Proof.Age != 1 {
+			t.Errorf("should validate the ages in the range: %v", p.Result)
+		}
+	}
+
+	if validated, err := s.ValidateAsset("b"); err != nil || validated.Code == statuscode.OK {
+		t.Errorf("should detect the tampered age out of the range: %v %v", validated, err)
+	}
+
+	if _, err = s.ValidateLedgers([]string{"a", ""}, service.LedgersValidationOptions{}); err == nil {
+		t.Errorf("should reject an empty asset ID")
+	}
+}
+
+func TestDeploy(t *testing.T) {
+	var (
+		_, s = scalardltest.NewTestClient(t, scalardltest.Options{
+			Auditor:   true,
+			Contracts: map[string]scalardltest.Contract{"com.org1.contract.StateUpdater": scalardltest.Counter},
+		})
+		m = manifest.Manifest{
+			RegisterCertificate: true,
+			Contracts:           []manifest.Contract{{ID: "state-updater", ClassFile: "../../example/StateUpdater.class"}},
+			Functions:           []manifest.Function{{ID: "state-reader", ClassFile: "../../example/StateReader.class"}},
+		}
+	)
+
+	report, err := s.Deploy(m)
+	if err != nil {
+		t.Fatalf("should deploy the manifest: %v", err)
+	}
+
+	if !report.HasChanges() || !report.CertificateAlreadyRegistered ||
+		fmt.Sprint(report.RegisteredContracts) != "[state-updater]" || fmt.Sprint(report.UpsertedFunctions) != "[state-reader]" {
+		t.Errorf("should register the contract and upsert the function: %+v", report)
+	}
+
+	if report, err = s.Deploy(m); err != nil {
+		t.Fatalf("should deploy the same manifest again: %v", err)
+	}
+
+	if report.HasChanges() ||
+		fmt.Sprint(report.AlreadyRegisteredContracts) != "[state-updater]" || fmt.Sprint(report.UpsertedFunctions) != "[state-reader]" {
+		t.Errorf("should not have changes when the manifest is deployed again: %+v", report)
+	}
+
+	if _, err = s.ExecuteContract("state-updater", sdkJSON.Object{"asset_id": "a", "amount": 1}, nil); err != nil {
+		t.Errorf("should execute the deployed contract: %v", err)
+	}
+
+	m.Contracts[0].ClassFile = "../../example/StateReader.class"
+
+	if _, err = s.Deploy(m); err == nil {
+		t.Errorf("should fail when the registered contract doesn't match the manifest")
+	}
+}
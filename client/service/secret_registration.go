@@ -0,0 +1,83 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	clientError "github.com/scalar-labs/scalardl-go-client-sdk/v3/client/error"
+	"github.com/scalar-labs/scalardl-go-client-sdk/v3/crypto"
+	"github.com/scalar-labs/scalardl-go-client-sdk/v3/ledger/model"
+	"github.com/scalar-labs/scalardl-go-client-sdk/v3/ledger/statuscode"
+	"github.com/scalar-labs/scalardl-go-client-sdk/v3/rpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// RegisterSecret registers the current secret key version to Ledger and Auditor for HMAC authentication.
+// It returns the error of a failed registration first, or the SecretAlreadyRegistered error
+// if the secret key has been registered before.
+func (s ClientService) RegisterSecret() (err error) {
+	var result model.CertificateRegistrationResult
+
+	if result, err = s.RegisterSecretWithResult(); err != nil {
+		return
+	}
+
+	return toRegistrationError(result)
+}
+
+// RegisterSecretWithResult registers the current secret key version to Ledger and Auditor,
+// and reports the outcome in each server in the same way as RegisterCertificateWithResult.
+func (s ClientService) RegisterSecretWithResult() (result model.CertificateRegistrationResult, err error) {
+	var key crypto.KeyringEntry
+
+	if key, err = s.currentKey(); err != nil {
+		return
+	}
+
+	if key.SecretKey == "" {
+		return result, fmt.Errorf("version %d in the keyring is not a secret key", key.Version)
+	}
+
+	return s.registerSecret(key)
+}
+
+// registerSecret sends the secret key to the privileged services.
+// The secret key is sent as it is, so the connections should be secured with TLS.
+func (s ClientService) registerSecret(key crypto.KeyringEntry) (result model.CertificateRegistrationResult, err error) {
+	var conns = s.acquire()
+	defer conns.release()
+
+	if conns.config.ClientMode != "CLIENT" {
+		return result, clientError.NewClientError(
+			statuscode.InvalidRequest,
+			"wrong mode specified",
+		)
+	}
+
+	var request = &rpc.SecretRegistrationRequest{
+		EntityId:   s.identity.CertHolderID,
+		KeyVersion: (uint32)(key.Version),
+		SecretKey:  key.SecretKey,
+	}
+
+	if conns.config.IsAuditorEnabled {
+		var (
+			privileged = rpc.NewAuditorPrivilegedClient(conns.auditorPrivileged)
+			trailer    metadata.MD
+		)
+
+		_, e := privileged.RegisterSecret(context.Background(), request, grpc.Trailer(&trailer))
+		result.Auditor = toRegistrationOutcome(e, trailer, statuscode.SecretAlreadyRegistered)
+	}
+
+	var (
+		privileged = rpc.NewLedgerPrivilegedClient(conns.ledgerPrivileged)
+		trailer    metadata.MD
+	)
+
+	_, e := privileged.RegisterSecret(context.Background(), request, grpc.Trailer(&trailer))
+	result.Ledger = toRegistrationOutcome(e, trailer, statuscode.SecretAlreadyRegistered)
+
+	return
+}
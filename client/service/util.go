@@ -29,3 +29,8 @@ func getClientErrorFromTrailer(trailer metadata.MD) (err clientError.ClientError
 
 	return
 }
+
+func isStatusCode(err error, code statuscode.StatusCode) bool {
+	e, ok := err.(clientError.ClientError)
+	return ok && e.StatusCode() == code
+}
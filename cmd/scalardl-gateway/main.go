@@ -0,0 +1,152 @@
+// Command scalardl-gateway serves the HTTP/JSON API of the gateway package with the client config:
+//
+//	scalardl-gateway --properties client.properties --tokens tokens.properties --listen :8080
+//
+// --identity adds the identity in another client config file, which the requests select by the X-Scalardl-Cert-Holder-Id header.
+// With --tokens, a properties file of the certificate holder IDs and their tokens, the requests must have
+// the token of the identity in the Authorization header, e.g. `Authorization: Bearer <token>`.
+// Without --tokens, it refuses to start unless --listen is a loopback address, 127.0.0.1:8080 by default.
+package main
+
+import (
+	"context"
+	"crypto/subtle"
+	"flag"
+	"fmt"
+	"log"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/magiconair/properties"
+	"github.com/scalar-labs/scalardl-go-client-sdk/v3/client/config"
+	"github.com/scalar-labs/scalardl-go-client-sdk/v3/client/service"
+	"github.com/scalar-labs/scalardl-go-client-sdk/v3/gateway"
+)
+
+// files is a flag that can be repeated.
+type files []string
+
+func (f *files) String() string {
+	return strings.Join(*f, ",")
+}
+
+func (f *files) Set(value string) error {
+	*f = append(*f, value)
+	return nil
+}
+
+var (
+	propertiesFile  = flag.String("properties", "client.properties", "the client config file of the default identity")
+	listen          = flag.String("listen", "127.0.0.1:8080", "the address to listen on")
+	tokensFile      = flag.String("tokens", "", "the properties file of the tokens of the identities")
+	maxRequestBytes = flag.Int64("max-request-bytes", gateway.DefaultMaxRequestBytes, "the limit of the size of the request bodies")
+	identityFiles   files
+)
+
+func main() {
+	flag.Var(&identityFiles, "identity", "the client config file of an additional identity (repeatable)")
+	flag.Parse()
+
+	c, err := config.LoadClientConfig(*propertiesFile)
+	if err != nil {
+		log.Fatalln(err)
+	}
+
+	var options = gateway.Options{MaxRequestBytes: *maxRequestBytes}
+
+	for _, path := range identityFiles {
+		ic, err := config.NewClientConfigFromFile(path)
+		if err != nil {
+			log.Fatalln(err)
+		}
+
+		identity, err := service.NewIdentityFromConfig(ic)
+		if err != nil {
+			log.Fatalf("%s: %v\n", path, err)
+		}
+
+		options.Identities = append(options.Identities, identity)
+	}
+
+	if *tokensFile != "" {
+		tokens, err := properties.LoadFile(*tokensFile, properties.UTF8)
+		if err != nil {
+			log.Fatalln(err)
+		}
+
+		options.Authorize = authorizeByTokens(tokens.Map())
+	} else if isLoopback(*listen) {
+		options.AllowUnauthenticated = true
+	} else {
+		log.Fatalf("--tokens is required to listen on %s, which is not a loopback address\n", *listen)
+	}
+
+	s, err := service.NewClientService(c)
+	if err != nil {
+		log.Fatalln(err)
+	}
+	defer s.Close()
+
+	g, err := gateway.New(s, options)
+	if err != nil {
+		log.Fatalln(err)
+	}
+
+	var server = &http.Server{Addr: *listen, Handler: g}
+
+	go func() {
+		var signals = make(chan os.Signal, 1)
+		signal.Notify(signals, os.Interrupt, syscall.SIGTERM)
+		<-signals
+
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+
+		server.Shutdown(ctx)
+	}()
+
+	log.Printf("serving the gateway on %s\n", *listen)
+
+	if err = server.ListenAndServe(); err != http.ErrServerClosed {
+		log.Println(err)
+	}
+}
+
+// authorizeByTokens requires the bearer token of the identity in the Authorization header.
+func authorizeByTokens(tokens map[string]string) func(r *http.Request, certHolderID string) error {
+	return func(r *http.Request, certHolderID string) error {
+		token, ok := tokens[certHolderID]
+		if !ok || token == "" {
+			return fmt.Errorf("no token is configured for %s", certHolderID)
+		}
+
+		var given = strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if subtle.ConstantTimeCompare([]byte(given), []byte(token)) != 1 {
+			return fmt.Errorf("the token of %s is not valid", certHolderID)
+		}
+
+		return nil
+	}
+}
+
+// isLoopback checks if the address only accepts the connections from the same host.
+// An address without the host, e.g. ":8080", listens on all the interfaces.
+func isLoopback(address string) bool {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil || host == "" {
+		return false
+	}
+
+	if host == "localhost" {
+		return true
+	}
+
+	ip := net.ParseIP(host)
+
+	return ip != nil && ip.IsLoopback()
+}
@@ -0,0 +1,20 @@
+package main
+
+import "testing"
+
+func TestIsLoopback(t *testing.T) {
+	for address, expected := range map[string]bool{
+		"127.0.0.1:8080":   true,
+		"[::1]:8080":       true,
+		"localhost:8080":   true,
+		":8080":            false,
+		"0.0.0.0:8080":     false,
+		"192.168.0.1:8080": false,
+		"example.com:8080": false,
+		"invalid":          false,
+	} {
+		if isLoopback(address) != expected {
+			t.Errorf("should tell if %s is a loopback address", address)
+		}
+	}
+}
@@ -0,0 +1,51 @@
+// Command scalardl-gen generates the typed Go client of the contracts in a manifest file:
+//
+//	scalardl-gen --manifest contracts.yaml --package bank --output contracts.go
+//
+// The argument, result, function and function_argument of the contracts in the manifest describe the generated code.
+// It is usually run by go generate, which sets the package name:
+//
+//	//go:generate go run github.com/scalar-labs/scalardl-go-client-sdk/v3/cmd/scalardl-gen --manifest contracts.yaml --output contracts.go
+package main
+
+import (
+	"flag"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+
+	"github.com/scalar-labs/scalardl-go-client-sdk/v3/client/contract/gen"
+	"github.com/scalar-labs/scalardl-go-client-sdk/v3/client/manifest"
+)
+
+var (
+	manifestFile = flag.String("manifest", "manifest.yaml", "the manifest file of the contracts (YAML or JSON)")
+	packageName  = flag.String("package", os.Getenv("GOPACKAGE"), "the package name of the generated code (the package of go generate by default)")
+	output       = flag.String("output", "", "the file to write the generated code to (the standard output by default)")
+)
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("scalardl-gen: ")
+	flag.Parse()
+
+	m, err := manifest.NewManifestFromFile(*manifestFile)
+	if err != nil {
+		log.Fatalln(err)
+	}
+
+	code, err := gen.Generate(m, gen.Options{Package: *packageName, Source: filepath.Base(*manifestFile)})
+	if err != nil {
+		log.Fatalln(err)
+	}
+
+	if *output == "" {
+		os.Stdout.Write(code)
+		return
+	}
+
+	if err = ioutil.WriteFile(*output, code, 0644); err != nil {
+		log.Fatalln(err)
+	}
+}
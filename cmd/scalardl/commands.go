@@ -0,0 +1,313 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	"github.com/scalar-labs/scalardl-go-client-sdk/v3/client/config"
+	"github.com/scalar-labs/scalardl-go-client-sdk/v3/client/service"
+	"github.com/scalar-labs/scalardl-go-client-sdk/v3/json"
+	"github.com/scalar-labs/scalardl-go-client-sdk/v3/ledger/model"
+	"github.com/scalar-labs/scalardl-go-client-sdk/v3/ledger/statuscode"
+)
+
+// usageError is an error of the flags of a command.
+type usageError string
+
+func (e usageError) Error() string {
+	return string(e)
+}
+
+// required returns usageError if any of the flags is empty.
+func required(flags map[string]string) error {
+	for name, value := range flags {
+		if value == "" {
+			return usageError(fmt.Sprintf("--%s is required", name))
+		}
+	}
+
+	return nil
+}
+
+// readJSON reads a JSON object given inline, or from the file at the path after @, e.g. @argument.json.
+// It returns nil for the empty value.
+func readJSON(name string, value string) (json.Object, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	if strings.HasPrefix(value, "@") {
+		content, err := ioutil.ReadFile(value[1:])
+		if err != nil {
+			return nil, err
+		}
+
+		value = string(content)
+	}
+
+	o, err := json.FromJSON(value)
+	if err != nil {
+		return nil, usageError(fmt.Sprintf("--%s must be a JSON object: %v", name, err))
+	}
+
+	return o, nil
+}
+
+// validation is the output of validate-ledger.
+type validation struct {
+	code         statuscode.StatusCode
+	Proof        *proof `json:"proof,omitempty"`
+	AuditorProof *proof `json:"auditor_proof,omitempty"`
+}
+
+func (v validation) statusCode() statuscode.StatusCode {
+	return v.code
+}
+
+func init() {
+	register(command{
+		name:        "register-cert",
+		description: "register the certificate, or the secret key for HMAC, in the client config",
+		define: func(flags *flag.FlagSet) func(config.ClientConfig, service.Client) (interface{}, error) {
+			return func(c config.ClientConfig, client service.Client) (interface{}, error) {
+				if c.AuthenticationMethod == config.AuthenticationMethodHMAC {
+					return nil, client.RegisterSecret()
+				}
+
+				return nil, client.RegisterCertificate()
+			}
+		},
+	})
+
+	register(command{
+		name:        "register-contract",
+		description: "register a contract from a class file or a jar file",
+		define: func(flags *flag.FlagSet) func(config.ClientConfig, service.Client) (interface{}, error) {
+			var (
+				id         = flags.String("contract-id", "", "the contract ID")
+				name       = flags.String("contract-binary-name", "", "the binary name of the contract, required for a jar file")
+				classFile  = flags.String("contract-class-file", "", "the class file of the contract")
+				jar        = flags.String("contract-jar", "", "the jar file with the contract class")
+				properties = flags.String("contract-properties", "", "the contract properties (JSON, or @file)")
+			)
+
+			return func(_ config.ClientConfig, client service.Client) (interface{}, error) {
+				if err := required(map[string]string{"contract-id": *id}); err != nil {
+					return nil, err
+				}
+
+				p, err := readJSON("contract-properties", *properties)
+				if err != nil {
+					return nil, err
+				}
+
+				switch {
+				case *jar != "":
+					if err = required(map[string]string{"contract-binary-name": *name}); err != nil {
+						return nil, err
+					}
+
+					return nil, client.RegisterContractFromJar(*id, *jar, *name, p)
+				case *classFile == "":
+					return nil, usageError("--contract-class-file or --contract-jar is required")
+				case *name != "":
+					contractBytes, err := ioutil.ReadFile(*classFile)
+					if err != nil {
+						return nil, err
+					}
+
+					return nil, client.RegisterContract(*id, *name, contractBytes, p)
+				default:
+					return nil, client.RegisterContractFromClassFile(*id, *classFile, p)
+				}
+			}
+		},
+	})
+
+	register(command{
+		name:        "register-function",
+		description: "register a function from a class file or a jar file",
+		define: func(flags *flag.FlagSet) func(config.ClientConfig, service.Client) (interface{}, error) {
+			var (
+				id        = flags.String("function-id", "", "the function ID")
+				name      = flags.String("function-binary-name", "", "the binary name of the function, required for a jar file")
+				classFile = flags.String("function-class-file", "", "the class file of the function")
+				jar       = flags.String("function-jar", "", "the jar file with the function class")
+			)
+
+			return func(_ config.ClientConfig, client service.Client) (interface{}, error) {
+				if err := required(map[string]string{"function-id": *id}); err != nil {
+					return nil, err
+				}
+
+				switch {
+				case *jar != "":
+					if err := required(map[string]string{"function-binary-name": *name}); err != nil {
+						return nil, err
+					}
+
+					return nil, client.RegisterFunctionFromJar(*id, *jar, *name)
+				case *classFile == "":
+					return nil, usageError("--function-class-file or --function-jar is required")
+				case *name != "":
+					functionBytes, err := ioutil.ReadFile(*classFile)
+					if err != nil {
+						return nil, err
+					}
+
+					return nil, client.RegisterFunction(*id, *name, functionBytes)
+				default:
+					return nil, client.RegisterFunctionFromClassFile(*id, *classFile)
+				}
+			}
+		},
+	})
+
+	register(command{
+		name:        "list-contracts",
+		description: "list the contracts registered by the certificate",
+		define: func(flags *flag.FlagSet) func(config.ClientConfig, service.Client) (interface{}, error) {
+			var id = flags.String("contract-id", "", "list only the contract of the ID")
+
+			return func(_ config.ClientConfig, client service.Client) (interface{}, error) {
+				return client.ListContracts(*id)
+			}
+		},
+	})
+
+	register(command{
+		name:        "execute-contract",
+		description: "execute a contract",
+		define: func(flags *flag.FlagSet) func(config.ClientConfig, service.Client) (interface{}, error) {
+			var (
+				id               = flags.String("contract-id", "", "the contract ID")
+				argument         = flags.String("contract-argument", "{}", "the contract argument (JSON, or @file)")
+				functionArgument = flags.String("function-argument", "", "the function argument (JSON, or @file)")
+				orderingKeys     = flags.String("ordering-keys", "", "the comma-separated ordering keys for Auditor")
+				preExecution     = flags.Bool("pre-execution", false, "execute the contract without committing")
+			)
+
+			return func(_ config.ClientConfig, client service.Client) (interface{}, error) {
+				if err := required(map[string]string{"contract-id": *id}); err != nil {
+					return nil, err
+				}
+
+				a, err := readJSON("contract-argument", *argument)
+				if err != nil {
+					return nil, err
+				}
+
+				if a == nil {
+					a = json.Object{}
+				}
+
+				f, err := readJSON("function-argument", *functionArgument)
+				if err != nil {
+					return nil, err
+				}
+
+				var options = service.ContractExecutionOptions{PreExecution: *preExecution}
+				if *orderingKeys != "" {
+					options.OrderingKeys = strings.Split(*orderingKeys, ",")
+				}
+
+				executed, err := client.ExecuteContractWithOptions(*id, a, f, options)
+				if err != nil {
+					return nil, err
+				}
+
+				var output = map[string]interface{}{
+					"nonce":  executed.Nonce,
+					"result": executed.Result,
+					"proofs": toProofs(executed.Proofs),
+				}
+
+				if len(executed.AuditorProofs) > 0 {
+					output["auditor_proofs"] = toProofs(executed.AuditorProofs)
+				}
+
+				return output, nil
+			}
+		},
+	})
+
+	register(command{
+		name:        "validate-ledger",
+		description: "validate an asset in Ledger, and in Auditor if it is enabled",
+		define: func(flags *flag.FlagSet) func(config.ClientConfig, service.Client) (interface{}, error) {
+			var (
+				id       = flags.String("asset-id", "", "the asset ID")
+				startAge = flags.Int("start-age", 0, "the age to start the validation from")
+				endAge   = flags.Int("end-age", service.JavaMaxIntValue, "the age to end the validation at")
+			)
+
+			return func(_ config.ClientConfig, client service.Client) (interface{}, error) {
+				if err := required(map[string]string{"asset-id": *id}); err != nil {
+					return nil, err
+				}
+
+				var (
+					validated model.LedgerValidationResult
+					err       error
+				)
+
+				if validated, err = client.ValidateLedgerRange(*id, *startAge, *endAge); err != nil {
+					return nil, err
+				}
+
+				return validation{
+					code:         validated.Code,
+					Proof:        toProof(validated.Proof),
+					AuditorProof: toProof(validated.AuditorProof),
+				}, nil
+			}
+		},
+	})
+
+	register(command{
+		name:        "retrieve-proof",
+		description: "retrieve the proof of an asset from Ledger",
+		define: func(flags *flag.FlagSet) func(config.ClientConfig, service.Client) (interface{}, error) {
+			var (
+				id  = flags.String("asset-id", "", "the asset ID")
+				age = flags.Int("age", -1, "the age of the asset, or -1 for the latest one")
+			)
+
+			return func(_ config.ClientConfig, client service.Client) (interface{}, error) {
+				if err := required(map[string]string{"asset-id": *id}); err != nil {
+					return nil, err
+				}
+
+				p, err := client.RetrieveAssetProof(*id, *age)
+				if err != nil {
+					return nil, err
+				}
+
+				return toProof(p), nil
+			}
+		},
+	})
+
+	register(command{
+		name:        "abort",
+		description: "abort a contract execution by its nonce unless it is committed",
+		define: func(flags *flag.FlagSet) func(config.ClientConfig, service.Client) (interface{}, error) {
+			var nonce = flags.String("nonce", "", "the nonce of the contract execution")
+
+			return func(_ config.ClientConfig, client service.Client) (interface{}, error) {
+				if err := required(map[string]string{"nonce": *nonce}); err != nil {
+					return nil, err
+				}
+
+				state, err := client.AbortExecution(*nonce)
+				if err != nil {
+					return nil, err
+				}
+
+				return map[string]string{"state": state.String()}, nil
+			}
+		},
+	})
+}
@@ -0,0 +1,154 @@
+// Command scalardl sends requests to a Scalar DL network with the client config in client.properties or a JSON file.
+//
+//	scalardl <command> [flags]
+//
+// The commands are register-cert, register-contract, register-function, list-contracts,
+// execute-contract, validate-ledger, retrieve-proof and abort. Run `scalardl <command> -h` for their flags.
+// `scalardl shell` starts an interactive shell for the same requests.
+//
+// Every command writes a JSON object to the standard output:
+//
+//	{"status_code": 200, "output": ...}
+//	{"status_code": 404, "error": "..."}
+//
+// where the status_code is the Scalar DL status code, which is omitted for the errors that are not from Scalar DL.
+// The exit code is 0 on success, 1 for the errors not from Scalar DL, 2 for the wrong usage,
+// and 3, 4 or 5 for the status codes of 300s, 400s and 500s respectively.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+
+	"github.com/scalar-labs/scalardl-go-client-sdk/v3/client/config"
+	"github.com/scalar-labs/scalardl-go-client-sdk/v3/client/service"
+)
+
+// command is a subcommand of scalardl.
+type command struct {
+	name        string
+	description string
+
+	// define defines the flags of the command on the flag set, and returns the function that runs the command.
+	define func(flags *flag.FlagSet) func(c config.ClientConfig, client service.Client) (output interface{}, err error)
+}
+
+var commands = map[string]command{}
+
+// register adds the command to the commands of scalardl.
+func register(c command) {
+	commands[c.name] = c
+}
+
+// newClient creates the client for the config. It is replaced in the tests.
+type newClient func(c config.ClientConfig) (service.Client, error)
+
+func newClientService(c config.ClientConfig) (service.Client, error) {
+	return service.NewClientService(c)
+}
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdin, os.Stdout, os.Stderr, newClientService))
+}
+
+// run runs the command in the arguments, and returns the exit code.
+func run(args []string, stdin io.Reader, stdout io.Writer, stderr io.Writer, connect newClient) int {
+	if len(args) == 0 || args[0] == "-h" || args[0] == "-help" || args[0] == "--help" || args[0] == "help" {
+		usage(stderr)
+		return exitUsage
+	}
+
+	if args[0] == shellName {
+		return runShell(args[1:], stdin, stdout, stderr, connect)
+	}
+
+	cmd, ok := commands[args[0]]
+	if !ok {
+		fmt.Fprintf(stderr, "unknown command: %s\n\n", args[0])
+		usage(stderr)
+
+		return exitUsage
+	}
+
+	var (
+		flags, properties = newFlagSet(cmd.name, stderr)
+		execute           = cmd.define(flags)
+	)
+
+	if code, ok := parse(flags, args[1:], stderr); !ok {
+		return code
+	}
+
+	c, err := config.LoadClientConfig(*properties)
+	if err != nil {
+		return write(stdout, stderr, nil, err)
+	}
+
+	client, err := connect(c)
+	if err != nil {
+		return write(stdout, stderr, nil, err)
+	}
+	defer client.Close()
+
+	output, err := execute(c, client)
+
+	return write(stdout, stderr, output, err)
+}
+
+// newFlagSet creates the flag set of the command with the flag of the client config file.
+func newFlagSet(name string, stderr io.Writer) (flags *flag.FlagSet, properties *string) {
+	flags = flag.NewFlagSet("scalardl "+name, flag.ContinueOnError)
+	flags.SetOutput(stderr)
+
+	properties = flags.String(
+		"properties",
+		"client.properties",
+		"the client config file in the properties, JSON, YAML or TOML format",
+	)
+
+	return
+}
+
+// parse parses the flags of the command, and returns the exit code if the command should not run.
+func parse(flags *flag.FlagSet, args []string, stderr io.Writer) (code int, ok bool) {
+	if err := flags.Parse(args); err != nil {
+		if err == flag.ErrHelp {
+			return exitOK, false
+		}
+
+		return exitUsage, false
+	}
+
+	if flags.NArg() > 0 {
+		fmt.Fprintf(stderr, "unexpected arguments: %v\n", flags.Args())
+		return exitUsage, false
+	}
+
+	return exitOK, true
+}
+
+// usage prints the commands of scalardl.
+func usage(w io.Writer) {
+	fmt.Fprintln(w, "Usage: scalardl <command> [flags]")
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "Commands:")
+
+	var names []string
+	for name := range commands {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	for _, name := range names {
+		fmt.Fprintf(w, "  %-20s%s\n", name, commands[name].description)
+	}
+
+	fmt.Fprintf(w, "  %-20s%s\n", shellName, shellDescription)
+
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "Run 'scalardl <command> -h' for the flags of a command.")
+}
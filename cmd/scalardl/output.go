@@ -0,0 +1,124 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	clientError "github.com/scalar-labs/scalardl-go-client-sdk/v3/client/error"
+	"github.com/scalar-labs/scalardl-go-client-sdk/v3/ledger/asset"
+	"github.com/scalar-labs/scalardl-go-client-sdk/v3/ledger/statuscode"
+)
+
+// The exit codes of scalardl.
+const (
+	exitOK         = 0
+	exitError      = 1
+	exitUsage      = 2
+	exitValidation = 3
+	exitRequest    = 4
+	exitServer     = 5
+)
+
+// result is the JSON object that a command writes.
+type result struct {
+	StatusCode statuscode.StatusCode `json:"status_code,omitempty"`
+	Output     interface{}           `json:"output,omitempty"`
+	Error      string                `json:"error,omitempty"`
+}
+
+// validated is the output with a Scalar DL status code other than the error, e.g. the ledger validation result.
+type validated interface {
+	statusCode() statuscode.StatusCode
+}
+
+// write writes the output or the error as a result, and returns the exit code for it.
+func write(stdout io.Writer, stderr io.Writer, output interface{}, err error) int {
+	var r = result{StatusCode: statuscode.OK, Output: output}
+
+	if v, ok := output.(validated); ok {
+		r.StatusCode = v.statusCode()
+	}
+
+	if err != nil {
+		r = result{Error: err.Error()}
+
+		if e, ok := err.(clientError.ClientError); ok {
+			r.StatusCode = e.StatusCode()
+		}
+	}
+
+	encoded, e := json.MarshalIndent(r, "", "  ")
+	if e != nil {
+		fmt.Fprintln(stderr, e)
+		return exitError
+	}
+
+	fmt.Fprintln(stdout, string(encoded))
+
+	if _, ok := err.(usageError); ok {
+		return exitUsage
+	}
+
+	return exitCode(r.StatusCode)
+}
+
+// exitCode maps a Scalar DL status code to an exit code. The status code is 0 for the errors not from Scalar DL.
+func exitCode(code statuscode.StatusCode) int {
+	switch {
+	case code == statuscode.OK:
+		return exitOK
+	case code >= 300 && code < 400:
+		return exitValidation
+	case code >= 400 && code < 500:
+		return exitRequest
+	case code >= 500:
+		return exitServer
+	default:
+		return exitError
+	}
+}
+
+// proof is the JSON representation of an asset proof. The binary values are base64-encoded.
+type proof struct {
+	AssetID   string      `json:"asset_id"`
+	Age       int32       `json:"age"`
+	Nonce     string      `json:"nonce"`
+	Input     interface{} `json:"input"`
+	Hash      []byte      `json:"hash"`
+	PrevHash  []byte      `json:"prev_hash,omitempty"`
+	Signature []byte      `json:"signature"`
+}
+
+// toProof converts an asset proof to the JSON representation, which is nil for the empty proof.
+func toProof(p asset.Proof) *proof {
+	if p.Equal(asset.Proof{}) {
+		return nil
+	}
+
+	var input interface{}
+	if p.Input != nil {
+		input = p.Input
+	}
+
+	return &proof{
+		AssetID:   p.ID,
+		Age:       p.Age,
+		Nonce:     p.Nonce,
+		Input:     input,
+		Hash:      p.Hash,
+		PrevHash:  p.PrevHash,
+		Signature: p.Signature,
+	}
+}
+
+// toProofs converts the asset proofs to the JSON representation.
+func toProofs(proofs []asset.Proof) []*proof {
+	var converted = make([]*proof, 0, len(proofs))
+
+	for _, p := range proofs {
+		converted = append(converted, toProof(p))
+	}
+
+	return converted
+}
@@ -0,0 +1,301 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/scalar-labs/scalardl-go-client-sdk/v3/client/config"
+	"github.com/scalar-labs/scalardl-go-client-sdk/v3/client/service"
+	sdkJSON "github.com/scalar-labs/scalardl-go-client-sdk/v3/json"
+	"github.com/scalar-labs/scalardl-go-client-sdk/v3/scalardltest"
+)
+
+type output struct {
+	StatusCode int                    `json:"status_code"`
+	Output     map[string]interface{} `json:"output"`
+	Error      string                 `json:"error"`
+}
+
+// setUp starts the in-process servers with alice registered, and writes the client config file of bob, who is not registered yet.
+func setUp(t *testing.T) (server *scalardltest.Server, properties string, connect newClient) {
+	server, _ = scalardltest.NewTestClient(t, scalardltest.Options{})
+
+	c, err := server.NewClientConfig("bob")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var (
+		dir  = t.TempDir()
+		key  = filepath.Join(dir, "key.pem")
+		cert = filepath.Join(dir, "cert.pem")
+	)
+
+	properties = filepath.Join(dir, "client.properties")
+
+	for path, content := range map[string]string{
+		properties: fmt.Sprintf(
+			"scalar.dl.client.server.host=%s\nscalar.dl.client.server.port=%d\nscalar.dl.client.server.privileged_port=%d\n"+
+				"scalar.dl.client.cert_holder_id=bob\nscalar.dl.client.cert_path=%s\nscalar.dl.client.private_key_path=%s\n",
+			c.LedgerHost, c.LedgerPort, c.LedgerPrivilegedPort, cert, key,
+		),
+		key:  c.PrivateKey,
+		cert: c.Cert,
+	} {
+		if err = ioutil.WriteFile(path, []byte(content), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	connect = func(c config.ClientConfig) (service.Client, error) {
+		return server.Connect(c)
+	}
+
+	return
+}
+
+func TestRun(t *testing.T) {
+	server, properties, connect := setUp(t)
+
+	var (
+		dir       = t.TempDir()
+		classFile = filepath.Join(dir, "Counter.class")
+		argument  = filepath.Join(dir, "argument.json")
+		err       error
+	)
+
+	for path, content := range map[string]string{
+		classFile: "\xCA\xFE",
+		argument:  `{"asset_id": "a", "amount": 10}`,
+	} {
+		if err = ioutil.WriteFile(path, []byte(content), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	var scalardl = func(args ...string) (code int, o output) {
+		var stdout, stderr bytes.Buffer
+
+		if len(args) > 0 {
+			args = append(args[:1], append([]string{"--properties", properties}, args[1:]...)...)
+		}
+
+		code = run(args, nil, &stdout, &stderr, connect)
+
+		if stdout.Len() > 0 {
+			if err := json.Unmarshal(stdout.Bytes(), &o); err != nil {
+				t.Errorf("should write JSON: %s", stdout.String())
+			}
+		}
+
+		return
+	}
+
+	if code, o := scalardl("register-cert"); code != 0 || o.StatusCode != 200 {
+		t.Errorf("should register the certificate: %d %v", code, o)
+	}
+
+	if code, o := scalardl("register-cert"); code != 4 || o.StatusCode != 405 || o.Error == "" {
+		t.Errorf("should exit with the status code class: %d %v", code, o)
+	}
+
+	code, o := scalardl(
+		"register-contract",
+		"--contract-id", "counter",
+		"--contract-binary-name", scalardltest.CounterName,
+		"--contract-class-file", classFile,
+		"--contract-properties", `{"limit": 100}`,
+	)
+	if code != 0 {
+		t.Errorf("should register the contract: %d %v", code, o)
+	}
+
+	if code, o = scalardl("list-contracts"); code != 0 || o.Output["counter"] == nil {
+		t.Errorf("should list the contract: %d %v", code, o)
+	}
+
+	code, o = scalardl("execute-contract", "--contract-id", "counter", "--contract-argument", "@"+argument)
+	if code != 0 || o.Output["result"].(map[string]interface{})["balance"] != float64(10) || o.Output["nonce"] == "" {
+		t.Fatalf("should execute the contract with the argument in the file: %d %v", code, o)
+	}
+
+	var nonce, _ = o.Output["nonce"].(string)
+
+	if code, o = scalardl("retrieve-proof", "--asset-id", "a", "--age", "0"); code != 0 || o.Output["nonce"] != nonce {
+		t.Errorf("should retrieve the proof: %d %v", code, o)
+	}
+
+	if code, o = scalardl("abort", "--nonce", nonce); code != 0 || o.Output["state"] != "COMMITTED" {
+		t.Errorf("should not abort the committed execution: %d %v", code, o)
+	}
+
+	if code, o = scalardl("validate-ledger", "--asset-id", "a"); code != 0 || o.StatusCode != 200 {
+		t.Errorf("should validate the asset: %d %v", code, o)
+	}
+
+	if err = server.TamperAsset("a", 0, sdkJSON.Object{"balance": 1000}); err != nil {
+		t.Fatal(err)
+	}
+
+	if code, o = scalardl("validate-ledger", "--asset-id", "a"); code != 3 || o.StatusCode != 300 {
+		t.Errorf("should exit with the status code of the validation: %d %v", code, o)
+	}
+
+	if code, o = scalardl("execute-contract", "--contract-argument", "{}"); code != 2 || o.Error == "" {
+		t.Errorf("should require the contract ID: %d %v", code, o)
+	}
+
+	if code, _ = scalardl("unknown"); code != 2 {
+		t.Errorf("should reject an unknown command: %d", code)
+	}
+
+	if code, _ = scalardl(); code != 2 {
+		t.Errorf("should print the usage: %d", code)
+	}
+}
+
+func TestShell(t *testing.T) {
+	_, properties, connect := setUp(t)
+
+	var (
+		classFile = filepath.Join(t.TempDir(), "Counter.class")
+		stdout    bytes.Buffer
+		stderr    bytes.Buffer
+	)
+
+	if err := ioutil.WriteFile(classFile, []byte{0xCA, 0xFE}, 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, args := range [][]string{
+		{"register-cert", "--properties", properties},
+		{"register-contract", "--properties", properties, "--contract-id", "counter",
+			"--contract-binary-name", scalardltest.CounterName, "--contract-class-file", classFile},
+	} {
+		if code := run(args, nil, &stdout, &stderr, connect); code != 0 {
+			t.Fatalf("should register: %s", stdout.String())
+		}
+	}
+
+	stdout.Reset()
+
+	var script = `contracts
+execute counter {"asset_id": "a",
+  "amount": 10}
+execute counter
+{"asset_id": "a", "amount": 5}
+walk a
+n
+n
+p
+q
+proof a
+abort unknown-nonce
+unknown
+history
+exit
+contracts
+`
+
+	if code := run([]string{"shell", "--properties", properties}, strings.NewReader(script), &stdout, &stderr, connect); code != 0 {
+		t.Errorf("should exit the shell: %d %s", code, stderr.String())
+	}
+
+	var output = stdout.String()
+
+	for _, expected := range []string{
+		`"counter": {`,
+		`"balance": 10`,
+		`"balance": 15`,
+		"a (age 0)",
+		"a has no age 2",
+		"state: ABORTED",
+		"unknown command: unknown",
+		"    2  execute counter {",
+	} {
+		if !strings.Contains(output, expected) {
+			t.Errorf("should print %q in the output:\n%s", expected, output)
+		}
+	}
+
+	if strings.Count(output, "a (age 1)") != 3 {
+		t.Errorf("should walk the ages back and forth, and show the latest proof:\n%s", output)
+	}
+
+	if strings.Count(output, `"counter": {`) != 1 {
+		t.Errorf("should exit before the last command:\n%s", output)
+	}
+}
+
+func TestShell_Edit(t *testing.T) {
+	_, properties, connect := setUp(t)
+
+	c, err := config.LoadClientConfig(properties)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	client, err := connect(c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Close()
+
+	if err = scalardltest.RegisterCounter(client); err != nil {
+		t.Fatal(err)
+	}
+
+	var (
+		stdout bytes.Buffer
+		edited []string
+		s      = &shell{
+			client: client,
+			in: lineScanner{scanner: bufio.NewScanner(strings.NewReader(
+				"execute counter {\"asset_id\": \"a\", \"amount\": 1}\nedit counter\n",
+			))},
+			out:       &stdout,
+			arguments: make(map[string]sdkJSON.Object),
+			editor: func(text []byte) ([]byte, error) {
+				edited = append(edited, string(text))
+				return []byte(strings.Replace(string(text), `"amount": 1`, `"amount": 2`, 1)), nil
+			},
+		}
+	)
+
+	s.run()
+
+	if len(edited) != 1 || strings.Contains(edited[0], "nonce") || !strings.Contains(edited[0], `"amount": 1`) {
+		t.Errorf("should edit the last argument without the nonce: %v", edited)
+	}
+
+	if !strings.Contains(stdout.String(), `"balance": 3`) {
+		t.Errorf("should execute the contract with the edited argument:\n%s", stdout.String())
+	}
+
+	for _, tc := range []struct {
+		line     string
+		expected string
+	}{
+		{"exe", "execute "},
+		{"ex", "ex"},
+		{"execute c", "execute counter "},
+		{"contracts ", "contracts counter "},
+		{"e", "e"},
+		{"validate c", "validate c"},
+	} {
+		line, pos, ok := s.complete(tc.line, len(tc.line), '\t')
+		if !ok || line != tc.expected || pos != len(tc.expected) {
+			t.Errorf("should complete %q to %q rather than %q", tc.line, tc.expected, line)
+		}
+	}
+
+	if _, _, ok := s.complete("ex", 2, 'a'); ok {
+		t.Errorf("should complete only on Tab")
+	}
+}
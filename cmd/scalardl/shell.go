@@ -0,0 +1,660 @@
+package main
+
+import (
+	"bufio"
+	"encoding/base64"
+	"encoding/hex"
+	ej "encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/scalar-labs/scalardl-go-client-sdk/v3/client/config"
+	clientError "github.com/scalar-labs/scalardl-go-client-sdk/v3/client/error"
+	"github.com/scalar-labs/scalardl-go-client-sdk/v3/client/service"
+	"github.com/scalar-labs/scalardl-go-client-sdk/v3/json"
+	"github.com/scalar-labs/scalardl-go-client-sdk/v3/ledger/asset"
+	"github.com/scalar-labs/scalardl-go-client-sdk/v3/ledger/model"
+	"github.com/scalar-labs/scalardl-go-client-sdk/v3/ledger/statuscode"
+	"golang.org/x/term"
+)
+
+const (
+	shellName        = "shell"
+	shellDescription = "start an interactive shell to explore the ledger"
+
+	prompt             = "scalardl> "
+	continuationPrompt = "... "
+	walkPrompt         = "[n]ext, [p]revious, age, or [q]uit> "
+)
+
+// shellCommand is a command in the shell.
+type shellCommand struct {
+	usage       string
+	description string
+	run         func(s *shell, args []string, rest string) error
+}
+
+// shellCommands are the commands in the shell by their names.
+var shellCommands map[string]shellCommand
+
+func init() {
+	shellCommands = map[string]shellCommand{
+		"help":      {"help", "show the commands", (*shell).help},
+		"contracts": {"contracts [contract-id]", "list the registered contracts", (*shell).contracts},
+		"execute": {
+			"execute <contract-id> [argument]",
+			"execute a contract; the JSON argument continues on the next lines until it is complete",
+			(*shell).execute,
+		},
+		"edit": {
+			"edit <contract-id>",
+			"edit the last argument of the contract in $EDITOR, and execute the contract with it",
+			(*shell).edit,
+		},
+		"validate": {"validate <asset-id> [start-age] [end-age]", "validate an asset", (*shell).validate},
+		"proof":    {"proof <asset-id> [age]", "show the proof of an age of an asset, the latest one by default", (*shell).proof},
+		"walk":     {"walk <asset-id> [age]", "walk the history of an asset age by age from the age, 0 by default", (*shell).walk},
+		"abort":    {"abort <nonce>", "abort a contract execution unless it is committed", (*shell).abort},
+		"history":  {"history", "show the command history", (*shell).history},
+		"exit":     {"exit", "exit the shell", nil},
+	}
+}
+
+// lineReader reads the lines of the shell: term.Terminal on a terminal, or lineScanner otherwise.
+type lineReader interface {
+	ReadLine() (string, error)
+	SetPrompt(prompt string)
+}
+
+// lineScanner reads the lines from a non-terminal input without prompts, e.g. a script piped to the shell.
+type lineScanner struct {
+	scanner *bufio.Scanner
+}
+
+func (l lineScanner) ReadLine() (string, error) {
+	if !l.scanner.Scan() {
+		if err := l.scanner.Err(); err != nil {
+			return "", err
+		}
+
+		return "", io.EOF
+	}
+
+	return l.scanner.Text(), nil
+}
+
+func (l lineScanner) SetPrompt(string) {}
+
+// shell is an interactive shell on a client.
+type shell struct {
+	client service.Client
+	in     lineReader
+	out    io.Writer
+
+	// contractIDs are the registered contract IDs to complete.
+	contractIDs []string
+
+	// commands are the command lines entered in the shell.
+	commands []string
+
+	// arguments are the last arguments of the contracts, which edit starts from.
+	arguments map[string]json.Object
+
+	// editor edits the text in $EDITOR.
+	editor func(text []byte) ([]byte, error)
+}
+
+// runShell runs the shell with the client config in the flags until exit or the end of the input.
+func runShell(args []string, stdin io.Reader, stdout io.Writer, stderr io.Writer, connect newClient) int {
+	var flags, properties = newFlagSet(shellName, stderr)
+
+	if code, ok := parse(flags, args, stderr); !ok {
+		return code
+	}
+
+	c, err := config.LoadClientConfig(*properties)
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+		return exitError
+	}
+
+	client, err := connect(c)
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+		return exitError
+	}
+	defer client.Close()
+
+	var s = &shell{
+		client:    client,
+		in:        lineScanner{scanner: bufio.NewScanner(stdin)},
+		out:       stdout,
+		arguments: make(map[string]json.Object),
+		editor:    editInEditor,
+	}
+
+	if f, ok := stdin.(*os.File); ok && term.IsTerminal(int(f.Fd())) {
+		state, err := term.MakeRaw(int(f.Fd()))
+		if err != nil {
+			fmt.Fprintln(stderr, err)
+			return exitError
+		}
+		defer term.Restore(int(f.Fd()), state)
+
+		var t = term.NewTerminal(struct {
+			io.Reader
+			io.Writer
+		}{stdin, stdout}, prompt)
+
+		t.AutoCompleteCallback = s.complete
+
+		s.in, s.out = t, t
+		s.editor = func(text []byte) ([]byte, error) {
+			// the editor needs the terminal in the normal mode.
+			term.Restore(int(f.Fd()), state)
+			defer term.MakeRaw(int(f.Fd()))
+
+			return editInEditor(text)
+		}
+	}
+
+	s.run()
+
+	return exitOK
+}
+
+// run reads and runs the commands until exit or the end of the input.
+func (s *shell) run() {
+	// the contract IDs to complete are loaded in advance, and the failure is reported by the first command.
+	s.loadContractIDs()
+
+	for {
+		line, err := s.in.ReadLine()
+		if err != nil {
+			return
+		}
+
+		var fields = strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+
+		s.commands = append(s.commands, line)
+
+		cmd, ok := shellCommands[fields[0]]
+		if !ok {
+			fmt.Fprintf(s.out, "unknown command: %s (type help for the commands)\n", fields[0])
+			continue
+		}
+
+		if cmd.run == nil {
+			return
+		}
+
+		// rest is the text after the command name, e.g. the JSON argument with whitespaces.
+		var rest = strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(line), fields[0]))
+
+		if err = cmd.run(s, fields[1:], rest); err != nil {
+			s.printError(err)
+		}
+	}
+}
+
+func (s *shell) help(_ []string, _ string) error {
+	var names []string
+	for name := range shellCommands {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	for _, name := range names {
+		fmt.Fprintf(s.out, "  %-45s%s\n", shellCommands[name].usage, shellCommands[name].description)
+	}
+
+	return nil
+}
+
+func (s *shell) contracts(args []string, _ string) error {
+	var id string
+	if len(args) > 0 {
+		id = args[0]
+	}
+
+	contracts, err := s.client.ListContracts(id)
+	if err != nil {
+		return err
+	}
+
+	if id == "" {
+		s.setContractIDs(contracts)
+	}
+
+	s.printJSON(contracts)
+
+	return nil
+}
+
+func (s *shell) execute(args []string, rest string) error {
+	if len(args) == 0 {
+		return fmt.Errorf("usage: %s", shellCommands["execute"].usage)
+	}
+
+	var id = args[0]
+
+	argument, err := s.readArgument(strings.TrimSpace(strings.TrimPrefix(rest, id)))
+	if err != nil {
+		return err
+	}
+
+	return s.executeWith(id, argument)
+}
+
+func (s *shell) edit(args []string, _ string) error {
+	if len(args) != 1 {
+		return fmt.Errorf("usage: %s", shellCommands["edit"].usage)
+	}
+
+	var argument = s.arguments[args[0]]
+	if argument == nil {
+		argument = json.Object{}
+	}
+
+	text, err := ej.MarshalIndent(argument, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if text, err = s.editor(text); err != nil {
+		return err
+	}
+
+	if argument, err = json.FromJSON(string(text)); err != nil {
+		return fmt.Errorf("the argument must be a JSON object: %w", err)
+	}
+
+	if argument == nil {
+		argument = json.Object{}
+	}
+
+	return s.executeWith(args[0], argument)
+}
+
+// executeWith executes the contract, and prints the result with the nonce to abort it.
+func (s *shell) executeWith(id string, argument json.Object) error {
+	// ExecuteContract adds the nonce to the argument, which must not be reused by edit.
+	s.arguments[id], _ = json.FromJSON(argument.String())
+
+	executed, err := s.client.ExecuteContract(id, argument, nil)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(s.out, "nonce: %s\n", executed.Nonce)
+	s.printResult(executed)
+
+	return nil
+}
+
+func (s *shell) validate(args []string, _ string) error {
+	if len(args) == 0 || len(args) > 3 {
+		return fmt.Errorf("usage: %s", shellCommands["validate"].usage)
+	}
+
+	var ages = []int{0, service.JavaMaxIntValue}
+
+	for i, arg := range args[1:] {
+		age, err := strconv.Atoi(arg)
+		if err != nil {
+			return fmt.Errorf("the age must be an integer: %s", arg)
+		}
+
+		ages[i] = age
+	}
+
+	validated, err := s.client.ValidateLedgerRange(args[0], ages[0], ages[1])
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(s.out, "status: %d\n", validated.Code)
+
+	if !validated.Proof.Equal(asset.Proof{}) {
+		fmt.Fprintln(s.out, "proof:")
+		s.printProof(validated.Proof, "  ")
+	}
+
+	if !validated.AuditorProof.Equal(asset.Proof{}) {
+		fmt.Fprintln(s.out, "auditor proof:")
+		s.printProof(validated.AuditorProof, "  ")
+	}
+
+	return nil
+}
+
+func (s *shell) proof(args []string, _ string) error {
+	if len(args) == 0 || len(args) > 2 {
+		return fmt.Errorf("usage: %s", shellCommands["proof"].usage)
+	}
+
+	var age = -1
+
+	if len(args) > 1 {
+		var err error
+		if age, err = strconv.Atoi(args[1]); err != nil {
+			return fmt.Errorf("the age must be an integer: %s", args[1])
+		}
+	}
+
+	p, err := s.client.RetrieveAssetProof(args[0], age)
+	if err != nil {
+		return err
+	}
+
+	s.printProof(p, "")
+
+	return nil
+}
+
+func (s *shell) walk(args []string, _ string) error {
+	if len(args) == 0 || len(args) > 2 {
+		return fmt.Errorf("usage: %s", shellCommands["walk"].usage)
+	}
+
+	var (
+		id      = args[0]
+		age     int
+		current = -1
+	)
+
+	if len(args) > 1 {
+		var err error
+		if age, err = strconv.Atoi(args[1]); err != nil || age < 0 {
+			return fmt.Errorf("the age must be a non-negative integer: %s", args[1])
+		}
+	}
+
+	s.in.SetPrompt(walkPrompt)
+	defer s.in.SetPrompt(prompt)
+
+	for {
+		if age != current {
+			p, err := s.client.RetrieveAssetProof(id, age)
+
+			switch {
+			case err == nil:
+				current = age
+				s.printProof(p, "")
+			case current < 0:
+				return err
+			case statusCodeOf(err) == statuscode.AssetNotFound:
+				fmt.Fprintf(s.out, "%s has no age %d\n", id, age)
+				age = current
+			default:
+				s.printError(err)
+				age = current
+			}
+		}
+
+		line, err := s.in.ReadLine()
+		if err != nil {
+			return nil
+		}
+
+		switch command := strings.TrimSpace(line); command {
+		case "", "n":
+			age = current + 1
+		case "p":
+			if current > 0 {
+				age = current - 1
+			}
+		case "q":
+			return nil
+		default:
+			if n, err := strconv.Atoi(command); err == nil && n >= 0 {
+				age = n
+			} else {
+				fmt.Fprintln(s.out, "unknown input:", command)
+			}
+		}
+	}
+}
+
+func (s *shell) abort(args []string, _ string) error {
+	if len(args) != 1 {
+		return fmt.Errorf("usage: %s", shellCommands["abort"].usage)
+	}
+
+	state, err := s.client.AbortExecution(args[0])
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(s.out, "state: %s\n", state)
+
+	return nil
+}
+
+func (s *shell) history(_ []string, _ string) error {
+	for i, command := range s.commands {
+		fmt.Fprintf(s.out, "%5d  %s\n", i+1, command)
+	}
+
+	return nil
+}
+
+// readArgument reads the JSON argument that starts with the text and continues on the next lines until it is complete.
+// An empty line ends the argument, and the argument is {} if it is empty.
+func (s *shell) readArgument(text string) (json.Object, error) {
+	s.in.SetPrompt(continuationPrompt)
+	defer s.in.SetPrompt(prompt)
+
+	for {
+		if strings.TrimSpace(text) != "" {
+			argument, err := json.FromJSON(text)
+
+			switch {
+			case err == nil && argument == nil:
+				return json.Object{}, nil
+			case err == nil:
+				return argument, nil
+			case err.Error() != "unexpected end of JSON input":
+				return nil, fmt.Errorf("the argument must be a JSON object: %w", err)
+			}
+		}
+
+		line, err := s.in.ReadLine()
+		if err != nil {
+			return nil, err
+		}
+
+		if strings.TrimSpace(line) == "" {
+			if strings.TrimSpace(text) == "" {
+				return json.Object{}, nil
+			}
+
+			return nil, fmt.Errorf("the argument is incomplete: %s", text)
+		}
+
+		text += "\n" + line
+	}
+}
+
+// complete completes the command names, and the contract IDs for the commands taking them, on Tab.
+func (s *shell) complete(line string, pos int, key rune) (newLine string, newPos int, ok bool) {
+	if key != '\t' {
+		return
+	}
+
+	var (
+		prefix = line[:pos]
+		fields = strings.Fields(prefix)
+		word   string
+	)
+
+	if len(fields) > 0 && !strings.HasSuffix(prefix, " ") {
+		word = fields[len(fields)-1]
+		fields = fields[:len(fields)-1]
+	}
+
+	var candidates []string
+
+	switch {
+	case len(fields) == 0:
+		for name := range shellCommands {
+			candidates = append(candidates, name)
+		}
+	case len(fields) == 1 && (fields[0] == "execute" || fields[0] == "edit" || fields[0] == "contracts"):
+		candidates = s.contractIDs
+	}
+
+	var matches []string
+
+	for _, candidate := range candidates {
+		if strings.HasPrefix(candidate, word) {
+			matches = append(matches, candidate)
+		}
+	}
+
+	if len(matches) == 0 {
+		return line, pos, true
+	}
+
+	var completed = commonPrefix(matches)
+	if len(matches) == 1 {
+		completed += " "
+	}
+
+	prefix = prefix[:len(prefix)-len(word)] + completed
+
+	return prefix + line[pos:], len(prefix), true
+}
+
+// commonPrefix returns the longest common prefix of the words.
+func commonPrefix(words []string) string {
+	var prefix = words[0]
+
+	for _, word := range words[1:] {
+		for !strings.HasPrefix(word, prefix) {
+			prefix = prefix[:len(prefix)-1]
+		}
+	}
+
+	return prefix
+}
+
+// loadContractIDs loads the registered contract IDs to complete.
+func (s *shell) loadContractIDs() {
+	if contracts, err := s.client.ListContracts(""); err == nil {
+		s.setContractIDs(contracts)
+	}
+}
+
+func (s *shell) setContractIDs(contracts json.Object) {
+	s.contractIDs = s.contractIDs[:0]
+
+	for id := range contracts {
+		s.contractIDs = append(s.contractIDs, id)
+	}
+
+	sort.Strings(s.contractIDs)
+}
+
+// printResult prints the result and the proofs of a contract execution.
+func (s *shell) printResult(executed model.ContractExecutionResult) {
+	fmt.Fprintln(s.out, "result:")
+	s.printJSON(executed.Result)
+
+	if len(executed.Proofs) > 0 {
+		fmt.Fprintln(s.out, "proofs:")
+
+		for _, p := range executed.Proofs {
+			s.printProof(p, "  ")
+		}
+	}
+
+	if len(executed.AuditorProofs) > 0 {
+		fmt.Fprintln(s.out, "auditor proofs:")
+
+		for _, p := range executed.AuditorProofs {
+			s.printProof(p, "  ")
+		}
+	}
+}
+
+// printProof prints an asset proof with the indent.
+func (s *shell) printProof(p asset.Proof, indent string) {
+	fmt.Fprintf(s.out, "%s%s (age %d)\n", indent, p.ID, p.Age)
+	fmt.Fprintf(s.out, "%s  nonce:     %s\n", indent, p.Nonce)
+	fmt.Fprintf(s.out, "%s  input:     %s\n", indent, p.Input.String())
+	fmt.Fprintf(s.out, "%s  hash:      %s\n", indent, hex.EncodeToString(p.Hash))
+	fmt.Fprintf(s.out, "%s  prev hash: %s\n", indent, hex.EncodeToString(p.PrevHash))
+	fmt.Fprintf(s.out, "%s  signature: %s\n", indent, base64.StdEncoding.EncodeToString(p.Signature))
+}
+
+// printJSON prints the value in indented JSON.
+func (s *shell) printJSON(v interface{}) {
+	encoded, err := ej.MarshalIndent(v, "", "  ")
+	if err != nil {
+		s.printError(err)
+		return
+	}
+
+	fmt.Fprintln(s.out, string(encoded))
+}
+
+// printError prints the error with the status code if it is from Scalar DL.
+func (s *shell) printError(err error) {
+	if code := statusCodeOf(err); code != 0 {
+		fmt.Fprintf(s.out, "error (%d): %v\n", code, err)
+	} else {
+		fmt.Fprintf(s.out, "error: %v\n", err)
+	}
+}
+
+// statusCodeOf returns the Scalar DL status code of the error, or 0 if it is not from Scalar DL.
+func statusCodeOf(err error) statuscode.StatusCode {
+	if e, ok := err.(clientError.ClientError); ok {
+		return e.StatusCode()
+	}
+
+	return 0
+}
+
+// editInEditor edits the text in a temporary file with $EDITOR, vi by default.
+func editInEditor(text []byte) ([]byte, error) {
+	f, err := ioutil.TempFile("", "scalardl-*.json")
+	if err != nil {
+		return nil, err
+	}
+	defer os.Remove(f.Name())
+
+	if _, err = f.Write(text); err != nil {
+		f.Close()
+		return nil, err
+	}
+
+	if err = f.Close(); err != nil {
+		return nil, err
+	}
+
+	var editor = strings.Fields(os.Getenv("EDITOR"))
+	if len(editor) == 0 {
+		editor = []string{"vi"}
+	}
+
+	var cmd = exec.Command(editor[0], append(editor[1:], f.Name())...)
+	cmd.Stdin, cmd.Stdout, cmd.Stderr = os.Stdin, os.Stdout, os.Stderr
+
+	if err = cmd.Run(); err != nil {
+		return nil, err
+	}
+
+	return ioutil.ReadFile(f.Name())
+}
@@ -0,0 +1,22 @@
+package crypto
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+)
+
+// ParseCertificate parses a certificate in the "CERTIFICATE" PEM format.
+func ParseCertificate(cert []byte) (certificate *x509.Certificate, err error) {
+	var block *pem.Block
+	if block, _ = pem.Decode(cert); block == nil || block.Type != "CERTIFICATE" {
+		return nil, errors.New("not a certificate")
+	}
+
+	if certificate, err = x509.ParseCertificate(block.Bytes); err != nil {
+		return nil, fmt.Errorf("failed to parse certificate: %w", err)
+	}
+
+	return
+}
@@ -4,8 +4,6 @@ import (
 	"crypto/ecdsa"
 	"crypto/rand"
 	"crypto/sha256"
-	"crypto/x509"
-	"encoding/pem"
 	"errors"
 )
 
@@ -15,22 +13,33 @@ type EcdsaSha256Signer struct {
 }
 
 // NewEcdsaSha256Signer parses given key and creates EcdsaSha256Signer.
+// The key can be in either the "EC PRIVATE KEY" (SEC 1) or the "PRIVATE KEY" (PKCS #8) PEM format.
 func NewEcdsaSha256Signer(key []byte) (s EcdsaSha256Signer, err error) {
-	var block *pem.Block
-	block, _ = pem.Decode(key)
+	return NewEcdsaSha256SignerWithPassphrase(key, nil)
+}
 
-	if block == nil || block.Type != "EC PRIVATE KEY" {
-		err = errors.New("not a EC private key")
+// NewEcdsaSha256SignerWithPassphrase parses given key, which can be encrypted with the passphrase, and creates EcdsaSha256Signer.
+func NewEcdsaSha256SignerWithPassphrase(key []byte, passphrase []byte) (s EcdsaSha256Signer, err error) {
+	var parsed interface{}
+
+	if parsed, err = ParsePrivateKey(key, passphrase); err != nil {
 		return
 	}
 
-	s.key, err = x509.ParseECPrivateKey(block.Bytes)
+	var ok bool
+	if s.key, ok = parsed.(*ecdsa.PrivateKey); !ok {
+		err = errors.New("not a EC private key")
+	}
 
 	return
 }
 
 // Sign signs given message.
 func (s EcdsaSha256Signer) Sign(message []byte) (signed []byte, err error) {
+	if s.key == nil {
+		return nil, errors.New("no private key is in the signer")
+	}
+
 	hash := sha256.Sum256(message)
 
 	return ecdsa.SignASN1(rand.Reader, s.key, hash[:])
@@ -38,22 +47,21 @@ func (s EcdsaSha256Signer) Sign(message []byte) (signed []byte, err error) {
 
 // EcdsaSha256Verifier is the verifier implementation of ECDSA-SHA256.
 type EcdsaSha256Verifier struct {
-	certificate *x509.Certificate
+	key *ecdsa.PublicKey
 }
 
 // NewEcdsaSha256Verifier parses given certificate and creates EcdsaSha256Verifier.
+// A bare public key in the "PUBLIC KEY" PEM format is accepted as well as a certificate.
 func NewEcdsaSha256Verifier(certificate []byte) (v EcdsaSha256Verifier, err error) {
-	var block *pem.Block
-	block, _ = pem.Decode(certificate)
+	var parsed interface{}
 
-	var parsed *x509.Certificate
-	if parsed, err = x509.ParseCertificate(block.Bytes); err != nil {
-		err = errors.New("failed to parse certificate")
+	if parsed, err = ParsePublicKey(certificate); err != nil {
 		return
 	}
 
-	v = EcdsaSha256Verifier{
-		certificate: parsed,
+	var ok bool
+	if v.key, ok = parsed.(*ecdsa.PublicKey); !ok {
+		err = errors.New("not a EC public key")
 	}
 
 	return
@@ -61,6 +69,10 @@ func NewEcdsaSha256Verifier(certificate []byte) (v EcdsaSha256Verifier, err erro
 
 // Verify verifies if given message and given signature are matched.
 func (v EcdsaSha256Verifier) Verify(message []byte, signature []byte) bool {
+	if v.key == nil {
+		return false
+	}
+
 	var hashed = sha256.Sum256(message)
-	return ecdsa.VerifyASN1(v.certificate.PublicKey.(*ecdsa.PublicKey), hashed[:], signature)
+	return ecdsa.VerifyASN1(v.key, hashed[:], signature)
 }
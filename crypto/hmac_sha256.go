@@ -0,0 +1,59 @@
+package crypto
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"errors"
+)
+
+// HmacSha256Signer is the signer implementation of HMAC-SHA256 with a secret key shared with Scalar DL networks.
+type HmacSha256Signer struct {
+	key []byte
+}
+
+// NewHmacSha256Signer creates HmacSha256Signer with the given secret key.
+func NewHmacSha256Signer(secretKey string) (s HmacSha256Signer, err error) {
+	if secretKey == "" {
+		return s, errors.New("secretKey cannot be empty")
+	}
+
+	return HmacSha256Signer{key: []byte(secretKey)}, nil
+}
+
+// Sign signs given message.
+func (s HmacSha256Signer) Sign(message []byte) (signed []byte, err error) {
+	if len(s.key) == 0 {
+		return nil, errors.New("no secret key is in the signer")
+	}
+
+	mac := hmac.New(sha256.New, s.key)
+	mac.Write(message)
+
+	return mac.Sum(nil), nil
+}
+
+// HmacSha256Verifier is the verifier implementation of HMAC-SHA256.
+type HmacSha256Verifier struct {
+	key []byte
+}
+
+// NewHmacSha256Verifier creates HmacSha256Verifier with the given secret key.
+func NewHmacSha256Verifier(secretKey string) (v HmacSha256Verifier, err error) {
+	if secretKey == "" {
+		return v, errors.New("secretKey cannot be empty")
+	}
+
+	return HmacSha256Verifier{key: []byte(secretKey)}, nil
+}
+
+// Verify verifies if given message and given signature are matched.
+func (v HmacSha256Verifier) Verify(message []byte, signature []byte) bool {
+	if len(v.key) == 0 {
+		return false
+	}
+
+	mac := hmac.New(sha256.New, v.key)
+	mac.Write(message)
+
+	return hmac.Equal(mac.Sum(nil), signature)
+}
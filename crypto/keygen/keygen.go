@@ -0,0 +1,149 @@
+// Package keygen provides the utilities to generate the key and the certificate of a Scalar DL client.
+// They replace the openssl commands for onboarding, and produce PEM strings that ClientConfig accepts as they are.
+package keygen
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"math/big"
+	"time"
+
+	"github.com/scalar-labs/scalardl-go-client-sdk/v3/crypto"
+)
+
+// DefaultValidity is the validity period of self-signed certificates when it is not specified.
+const DefaultValidity = 365 * 24 * time.Hour
+
+// GenerateKey generates an EC P-256 private key in the "EC PRIVATE KEY" PEM format,
+// which is the format NewEcdsaSha256Signer expects.
+func GenerateKey() (privateKey string, err error) {
+	var key *ecdsa.PrivateKey
+
+	if key, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader); err != nil {
+		return
+	}
+
+	var der []byte
+
+	if der, err = x509.MarshalECPrivateKey(key); err != nil {
+		return
+	}
+
+	return string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: der})), nil
+}
+
+// CreateCSR creates a certificate signing request of the given subject signed by the given private key.
+// The CSR in the "CERTIFICATE REQUEST" PEM format is submitted to a CA to issue the client certificate.
+func CreateCSR(privateKey string, subject pkix.Name) (csr string, err error) {
+	var key *ecdsa.PrivateKey
+
+	if key, err = parsePrivateKey(privateKey); err != nil {
+		return
+	}
+
+	var der []byte
+
+	if der, err = x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{
+		Subject:            subject,
+		SignatureAlgorithm: x509.ECDSAWithSHA256,
+	}, key); err != nil {
+		return
+	}
+
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: der})), nil
+}
+
+// SelfSign creates a certificate of the given subject self-signed by the given private key.
+// The certificate is valid from now for the given period, or for DefaultValidity if the period is not positive.
+// Self-signed certificates are only for local development since Scalar DL networks in production
+// accept the certificates issued by their CA.
+func SelfSign(privateKey string, subject pkix.Name, validity time.Duration) (cert string, err error) {
+	var key *ecdsa.PrivateKey
+
+	if key, err = parsePrivateKey(privateKey); err != nil {
+		return
+	}
+
+	if validity <= 0 {
+		validity = DefaultValidity
+	}
+
+	var serialNumber *big.Int
+
+	if serialNumber, err = rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128)); err != nil {
+		return
+	}
+
+	var (
+		now      = time.Now()
+		template = &x509.Certificate{
+			SerialNumber:          serialNumber,
+			Subject:               subject,
+			NotBefore:             now,
+			NotAfter:              now.Add(validity),
+			KeyUsage:              x509.KeyUsageDigitalSignature,
+			ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+			BasicConstraintsValid: true,
+			SignatureAlgorithm:    x509.ECDSAWithSHA256,
+		}
+		der []byte
+	)
+
+	if der, err = x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key); err != nil {
+		return
+	}
+
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})), nil
+}
+
+// CheckKeyPair checks if the given certificate and private key form a matching pair.
+// A certificate registered with a mismatched private key makes every signed request fail,
+// so it is worth checking before RegisterCertificate.
+func CheckKeyPair(cert string, privateKey string) (err error) {
+	var key, publicKey interface{}
+
+	if key, err = crypto.ParsePrivateKey([]byte(privateKey), nil); err != nil {
+		return
+	}
+
+	if publicKey, err = crypto.ParsePublicKey([]byte(cert)); err != nil {
+		return
+	}
+
+	var matched bool
+
+	switch k := key.(type) {
+	case *ecdsa.PrivateKey:
+		matched = k.PublicKey.Equal(publicKey)
+	case *rsa.PrivateKey:
+		matched = k.PublicKey.Equal(publicKey)
+	}
+
+	if !matched {
+		return errors.New("the certificate and the private key don't match")
+	}
+
+	return
+}
+
+// parsePrivateKey parses an EC private key in either the SEC 1 or the PKCS #8 PEM format.
+func parsePrivateKey(privateKey string) (key *ecdsa.PrivateKey, err error) {
+	var parsed interface{}
+
+	if parsed, err = crypto.ParsePrivateKey([]byte(privateKey), nil); err != nil {
+		return
+	}
+
+	var ok bool
+	if key, ok = parsed.(*ecdsa.PrivateKey); !ok {
+		err = errors.New("not a EC private key")
+	}
+
+	return
+}
@@ -0,0 +1,99 @@
+package keygen
+
+import (
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"testing"
+	"time"
+
+	"github.com/scalar-labs/scalardl-go-client-sdk/v3/crypto"
+)
+
+func TestGenerateKey(t *testing.T) {
+	privateKey, err := GenerateKey()
+	if err != nil {
+		t.Errorf("should generate a key: %v", err)
+	}
+
+	if _, err = crypto.NewEcdsaSha256Signer([]byte(privateKey)); err != nil {
+		t.Errorf("should generate a key that NewEcdsaSha256Signer accepts")
+	}
+}
+
+func TestCreateCSR(t *testing.T) {
+	privateKey, _ := GenerateKey()
+
+	if _, err := CreateCSR("not a key", pkix.Name{CommonName: "foo"}); err == nil {
+		t.Errorf("should reject an invalid private key")
+	}
+
+	csr, err := CreateCSR(privateKey, pkix.Name{CommonName: "foo", Organization: []string{"Example"}})
+	if err != nil {
+		t.Errorf("should create a CSR: %v", err)
+	}
+
+	block, _ := pem.Decode([]byte(csr))
+	if block == nil || block.Type != "CERTIFICATE REQUEST" {
+		t.Fatalf("should be encoded in PEM")
+	}
+
+	parsed, err := x509.ParseCertificateRequest(block.Bytes)
+	if err != nil || parsed.CheckSignature() != nil {
+		t.Errorf("should be signed by the private key")
+	}
+
+	if parsed.Subject.CommonName != "foo" {
+		t.Errorf("should have the subject")
+	}
+}
+
+func TestSelfSign(t *testing.T) {
+	privateKey, _ := GenerateKey()
+
+	cert, err := SelfSign(privateKey, pkix.Name{CommonName: "foo"}, time.Hour)
+	if err != nil {
+		t.Errorf("should self-sign a certificate: %v", err)
+	}
+
+	block, _ := pem.Decode([]byte(cert))
+	parsed, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		t.Fatalf("should be a certificate: %v", err)
+	}
+
+	if parsed.NotAfter.Sub(parsed.NotBefore) != time.Hour {
+		t.Errorf("should be valid for the given period")
+	}
+
+	var (
+		signer, _   = crypto.NewEcdsaSha256Signer([]byte(privateKey))
+		verifier, _ = crypto.NewEcdsaSha256Verifier([]byte(cert))
+		message     = []byte("hello world!")
+	)
+
+	signature, _ := signer.Sign(message)
+	if !verifier.Verify(message, signature) {
+		t.Errorf("should verify the signatures by the private key")
+	}
+}
+
+func TestCheckKeyPair(t *testing.T) {
+	var (
+		privateKey1, _ = GenerateKey()
+		privateKey2, _ = GenerateKey()
+		cert1, _       = SelfSign(privateKey1, pkix.Name{CommonName: "foo"}, 0)
+	)
+
+	if err := CheckKeyPair(cert1, privateKey1); err != nil {
+		t.Errorf("should accept a matching pair: %v", err)
+	}
+
+	if err := CheckKeyPair(cert1, privateKey2); err == nil {
+		t.Errorf("should reject a mismatched pair")
+	}
+
+	if err := CheckKeyPair("not a cert", privateKey1); err == nil {
+		t.Errorf("should reject an invalid certificate")
+	}
+}
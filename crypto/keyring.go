@@ -0,0 +1,158 @@
+package crypto
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+)
+
+// KeyringEntry defines a version of the certificate along with the signer and the verifier of it.
+// For HMAC authentication, SecretKey is set instead of Cert.
+type KeyringEntry struct {
+	Version   int
+	Cert      string
+	SecretKey string
+	Signer    Signer
+	Verifier  Verifier
+}
+
+// Keyring holds the versions of the certificates of a certificate holder,
+// and the current version that requests are signed with.
+// It is safe for concurrent use, so the current version can be switched at runtime.
+type Keyring struct {
+	mutex   sync.RWMutex
+	entries map[int]KeyringEntry
+	current int
+}
+
+// NewKeyring creates an empty Keyring.
+func NewKeyring() *Keyring {
+	return &Keyring{entries: make(map[int]KeyringEntry)}
+}
+
+// Add parses the given certificate and private key, and adds them as the given version.
+// The signature algorithm follows the key type as NewSigner does.
+// The first version added becomes the current version.
+func (k *Keyring) Add(version int, cert string, privateKey string) (err error) {
+	var entry = KeyringEntry{Version: version, Cert: cert}
+
+	if entry.Signer, err = NewSigner([]byte(privateKey), nil); err != nil {
+		return
+	}
+
+	if entry.Verifier, err = NewVerifier([]byte(cert)); err != nil {
+		return
+	}
+
+	return k.AddEntry(entry)
+}
+
+// AddSecret adds the given secret key for HMAC authentication as the given version.
+// The first version added becomes the current version.
+func (k *Keyring) AddSecret(version int, secretKey string) (err error) {
+	var entry = KeyringEntry{Version: version, SecretKey: secretKey}
+
+	if entry.Signer, err = NewHmacSha256Signer(secretKey); err != nil {
+		return
+	}
+
+	if entry.Verifier, err = NewHmacSha256Verifier(secretKey); err != nil {
+		return
+	}
+
+	return k.AddEntry(entry)
+}
+
+// AddEntry adds the given entry. An existing entry of the same version is replaced.
+// The first version added becomes the current version.
+func (k *Keyring) AddEntry(entry KeyringEntry) error {
+	if entry.Signer == nil {
+		return fmt.Errorf("the signer of version %d cannot be nil", entry.Version)
+	}
+
+	k.mutex.Lock()
+	defer k.mutex.Unlock()
+
+	if len(k.entries) == 0 {
+		k.current = entry.Version
+	}
+
+	k.entries[entry.Version] = entry
+
+	return nil
+}
+
+// Use switches the current version to the given one.
+func (k *Keyring) Use(version int) error {
+	k.mutex.Lock()
+	defer k.mutex.Unlock()
+
+	if _, ok := k.entries[version]; !ok {
+		return fmt.Errorf("version %d is not in the keyring", version)
+	}
+
+	k.current = version
+
+	return nil
+}
+
+// Remove removes the given version. The current version cannot be removed.
+func (k *Keyring) Remove(version int) error {
+	k.mutex.Lock()
+	defer k.mutex.Unlock()
+
+	if version == k.current {
+		return fmt.Errorf("the current version %d cannot be removed", version)
+	}
+
+	delete(k.entries, version)
+
+	return nil
+}
+
+// Current returns the entry of the current version.
+// The version and the signer should be taken from the same entry to sign a request consistently.
+func (k *Keyring) Current() (entry KeyringEntry, ok bool) {
+	k.mutex.RLock()
+	defer k.mutex.RUnlock()
+
+	entry, ok = k.entries[k.current]
+
+	return
+}
+
+// Get returns the entry of the given version.
+func (k *Keyring) Get(version int) (entry KeyringEntry, ok bool) {
+	k.mutex.RLock()
+	defer k.mutex.RUnlock()
+
+	entry, ok = k.entries[version]
+
+	return
+}
+
+// Versions returns all the versions in the keyring in ascending order.
+func (k *Keyring) Versions() (versions []int) {
+	k.mutex.RLock()
+	defer k.mutex.RUnlock()
+
+	for v := range k.entries {
+		versions = append(versions, v)
+	}
+
+	sort.Ints(versions)
+
+	return
+}
+
+// Verify checks if the signature is made by any version in the keyring,
+// so signatures made before a rotation are still verified.
+func (k *Keyring) Verify(message []byte, signature []byte) bool {
+	for _, v := range k.Versions() {
+		if entry, ok := k.Get(v); ok && entry.Verifier != nil && entry.Verifier.Verify(message, signature) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,73 @@
+package crypto
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"errors"
+)
+
+// RsaSha256Signer is the signer implementation of RSA-SHA256 (RSASSA-PKCS1-v1_5).
+type RsaSha256Signer struct {
+	key *rsa.PrivateKey
+}
+
+// NewRsaSha256Signer parses given key, which can be encrypted with the passphrase, and creates RsaSha256Signer.
+// The key can be in either the "RSA PRIVATE KEY" (PKCS #1) or the "PRIVATE KEY" (PKCS #8) PEM format.
+func NewRsaSha256Signer(key []byte, passphrase []byte) (s RsaSha256Signer, err error) {
+	var parsed interface{}
+
+	if parsed, err = ParsePrivateKey(key, passphrase); err != nil {
+		return
+	}
+
+	var ok bool
+	if s.key, ok = parsed.(*rsa.PrivateKey); !ok {
+		err = errors.New("not a RSA private key")
+	}
+
+	return
+}
+
+// Sign signs given message.
+func (s RsaSha256Signer) Sign(message []byte) (signed []byte, err error) {
+	if s.key == nil {
+		return nil, errors.New("no private key is in the signer")
+	}
+
+	hash := sha256.Sum256(message)
+
+	return rsa.SignPKCS1v15(rand.Reader, s.key, crypto.SHA256, hash[:])
+}
+
+// RsaSha256Verifier is the verifier implementation of RSA-SHA256 (RSASSA-PKCS1-v1_5).
+type RsaSha256Verifier struct {
+	key *rsa.PublicKey
+}
+
+// NewRsaSha256Verifier parses given certificate or public key and creates RsaSha256Verifier.
+func NewRsaSha256Verifier(certificate []byte) (v RsaSha256Verifier, err error) {
+	var parsed interface{}
+
+	if parsed, err = ParsePublicKey(certificate); err != nil {
+		return
+	}
+
+	var ok bool
+	if v.key, ok = parsed.(*rsa.PublicKey); !ok {
+		err = errors.New("not a RSA public key")
+	}
+
+	return
+}
+
+// Verify verifies if given message and given signature are matched.
+func (v RsaSha256Verifier) Verify(message []byte, signature []byte) bool {
+	if v.key == nil {
+		return false
+	}
+
+	var hashed = sha256.Sum256(message)
+	return rsa.VerifyPKCS1v15(v.key, crypto.SHA256, hashed[:], signature) == nil
+}
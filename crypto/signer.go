@@ -1,6 +1,29 @@
 package crypto
 
+import (
+	"crypto/ecdsa"
+	"crypto/rsa"
+)
+
 // Signer defines an interface to sign messages.
 type Signer interface {
 	Sign(message []byte) (signature []byte, err error)
 }
+
+// NewSigner parses given key, which can be encrypted with the passphrase,
+// and creates the signer of the algorithm for the key type: ECDSA-SHA256 or RSA-SHA256.
+// The passphrase can be nil for an unencrypted key.
+func NewSigner(key []byte, passphrase []byte) (s Signer, err error) {
+	var parsed interface{}
+
+	if parsed, err = ParsePrivateKey(key, passphrase); err != nil {
+		return
+	}
+
+	switch k := parsed.(type) {
+	case *ecdsa.PrivateKey:
+		return EcdsaSha256Signer{key: k}, nil
+	default:
+		return RsaSha256Signer{key: k.(*rsa.PrivateKey)}, nil
+	}
+}
@@ -1,6 +1,28 @@
 package crypto
 
+import (
+	"crypto/ecdsa"
+	"crypto/rsa"
+)
+
 // Verifier defines an interface to verify if the message and the signature are matched.
 type Verifier interface {
 	Verify(message []byte, signature []byte) (verified bool)
 }
+
+// NewVerifier parses given certificate or public key,
+// and creates the verifier of the algorithm for the key type: ECDSA-SHA256 or RSA-SHA256.
+func NewVerifier(certificate []byte) (v Verifier, err error) {
+	var parsed interface{}
+
+	if parsed, err = ParsePublicKey(certificate); err != nil {
+		return
+	}
+
+	switch k := parsed.(type) {
+	case *ecdsa.PublicKey:
+		return EcdsaSha256Verifier{key: k}, nil
+	default:
+		return RsaSha256Verifier{key: k.(*rsa.PublicKey)}, nil
+	}
+}
@@ -4,7 +4,6 @@ import (
 	"context"
 	"flag"
 	"fmt"
-	"io/ioutil"
 	"math/rand"
 	"os"
 	"sync"
@@ -12,14 +11,16 @@ import (
 	"time"
 
 	client_config "github.com/scalar-labs/scalardl-go-client-sdk/v3/client/config"
+	"github.com/scalar-labs/scalardl-go-client-sdk/v3/client/contract"
 	client_error "github.com/scalar-labs/scalardl-go-client-sdk/v3/client/error"
+	"github.com/scalar-labs/scalardl-go-client-sdk/v3/client/manifest"
 	client_service "github.com/scalar-labs/scalardl-go-client-sdk/v3/client/service"
 	"github.com/scalar-labs/scalardl-go-client-sdk/v3/json"
-	"github.com/scalar-labs/scalardl-go-client-sdk/v3/ledger/statuscode"
 )
 
 var (
 	propertiesFile = flag.String("properties", "client.properties", "the properties file")
+	manifestFile   = flag.String("manifest", "smallbank.yaml", "the manifest file of the contracts")
 	accountNum     = flag.Int("num-accounts", 10000, "the number of target accounts")
 	concurrencyNum = flag.Int("num-concurrencies", 1, "the number of concurrencies to run")
 	duration       = flag.Int("duration", 200, "the duration of benchmark in seconds")
@@ -40,28 +41,15 @@ func main() {
 	}
 	defer service.Close()
 
-	if err = service.RegisterCertificate(); err != nil {
-		if clientErr, ok := err.(client_error.ClientError); ok {
-			if clientErr.StatusCode() != statuscode.CertificateAlreadyRegistered {
-				printError(err)
-				os.Exit(1)
-			}
-		} else {
-			printError(err)
-			os.Exit(1)
-		}
+	var deployment manifest.Manifest
+	if deployment, err = manifest.NewManifestFromFile(*manifestFile); err != nil {
+		printError(err)
+		os.Exit(1)
 	}
 
-	if err = registerContracts(service); err != nil {
-		if clientErr, ok := err.(client_error.ClientError); ok {
-			if clientErr.StatusCode() != statuscode.ContractAlreadyRegistered {
-				printError(err)
-				os.Exit(1)
-			}
-		} else {
-			printError(err)
-			os.Exit(1)
-		}
+	if _, err = service.Deploy(deployment); err != nil {
+		printError(err)
+		os.Exit(1)
 	}
 
 	createAccounts(service)
@@ -182,102 +170,24 @@ func printError(err error) {
 	}
 }
 
+// account is the argument of the create_account contract.
+type account struct {
+	CustomerID             int    `json:"customer_id"`
+	CustomerName           string `json:"customer_name"`
+	InitialCheckingBalance int    `json:"initial_checking_balance"`
+	InitialSavingsBalance  int    `json:"initial_savings_balance"`
+}
+
 func createClientService(file string) (service client_service.ClientService, err error) {
-	var properties []byte
 	var config client_config.ClientConfig
 
-	if properties, err = ioutil.ReadFile(*propertiesFile); err != nil {
-		return
-	}
-
-	if config, err = client_config.NewClientConfigFromJavaProperties(string(properties)); err != nil {
+	if config, err = client_config.NewClientConfigFromFile(file); err != nil {
 		return
 	}
 
 	return client_service.NewClientService(config)
 }
 
-func registerContracts(service client_service.ClientService) (err error) {
-	var (
-		contractBytes      []byte
-		contractProperties json.Object
-	)
-
-	if contractBytes, err = os.ReadFile("Amalgamate.class"); err != nil {
-		return
-	}
-	if err = service.RegisterContract(
-		"amalgamate",
-		"com.example.contract.smallbank.Amalgamate",
-		contractBytes,
-		contractProperties,
-	); err != nil {
-		return
-	}
-
-	if contractBytes, err = os.ReadFile("CreateAccount.class"); err != nil {
-		return
-	}
-	if err = service.RegisterContract(
-		"create_account",
-		"com.example.contract.smallbank.CreateAccount",
-		contractBytes,
-		contractProperties,
-	); err != nil {
-		return
-	}
-
-	if contractBytes, err = os.ReadFile("DepositChecking.class"); err != nil {
-		return
-	}
-	if err = service.RegisterContract(
-		"deposit_checking",
-		"com.example.contract.smallbank.DepositChecking",
-		contractBytes,
-		contractProperties,
-	); err != nil {
-		return
-	}
-
-	if contractBytes, err = os.ReadFile("SendPayment.class"); err != nil {
-		return
-	}
-	if err = service.RegisterContract(
-		"send_payment",
-		"com.example.contract.smallbank.SendPayment",
-		contractBytes,
-		contractProperties,
-	); err != nil {
-		return
-	}
-
-	if contractBytes, err = os.ReadFile("TransactSavings.class"); err != nil {
-		return
-	}
-	if err = service.RegisterContract(
-		"transact_savings",
-		"com.example.contract.smallbank.TransactSavings",
-		contractBytes,
-		contractProperties,
-	); err != nil {
-		return
-	}
-
-	if contractBytes, err = os.ReadFile("WriteCheck.class"); err != nil {
-		return
-	}
-	if err = service.RegisterContract(
-		"write_check",
-		"com.example.contract.smallbank.WriteCheck",
-		contractBytes,
-		contractProperties,
-	); err != nil {
-		return
-	}
-
-	return
-}
-
 func createAccounts(s client_service.ClientService) (err error) {
 	if *concurrencyNum > *accountNum {
 		*concurrencyNum = *accountNum
@@ -305,22 +215,24 @@ func createAccounts(s client_service.ClientService) (err error) {
 		i = (i + 1) % *concurrencyNum
 	}
 
-	var wg sync.WaitGroup
+	var (
+		wg            sync.WaitGroup
+		createAccount = contract.NewHandle(s, "create_account", account{}, nil)
+	)
 
 	for i = 0; i < *concurrencyNum; i++ {
 		var accounts []int = chunks[i]
 
 		wg.Add(1)
 
-		go func(s client_service.ClientService, accounts []int) {
+		go func(accounts []int) {
 			for _, a := range accounts {
-				if _, err = s.ExecuteContract(
-					"create_account",
-					json.Object{
-						"customer_id":              a,
-						"customer_name":            fmt.Sprintf("Number %d", a),
-						"initial_checking_balance": 100000,
-						"initial_savings_balance":  100000,
+				if _, err = createAccount.Execute(
+					account{
+						CustomerID:             a,
+						CustomerName:           fmt.Sprintf("Number %d", a),
+						InitialCheckingBalance: 100000,
+						InitialSavingsBalance:  100000,
 					},
 					nil,
 				); err == nil {
@@ -329,7 +241,7 @@ func createAccounts(s client_service.ClientService) (err error) {
 			}
 
 			wg.Done()
-		}(s, accounts)
+		}(accounts)
 	}
 
 	wg.Wait()
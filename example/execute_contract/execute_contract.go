@@ -2,8 +2,8 @@ package main
 
 import (
 	"flag"
-	"io/ioutil"
 	"log"
+	"strings"
 
 	client_config "github.com/scalar-labs/scalardl-go-client-sdk/v3/client/config"
 	client_error "github.com/scalar-labs/scalardl-go-client-sdk/v3/client/error"
@@ -16,21 +16,18 @@ var propertiesFile = flag.String("properties", "client.properties", "the propert
 var id = flag.String("id", "", "the contract ID")
 var argumentJSON = flag.String("argument", "{}", "the argument (JSON)")
 var functionArgumentJSON = flag.String("function_argument", "", "the function argument (JSON)")
+var orderingKeys = flag.String("ordering_keys", "", "the comma-separated ordering keys for Auditor")
+var preExecution = flag.Bool("pre_execution", false, "execute the contract without committing")
 
 func main() {
 	flag.Parse()
 
 	var (
 		clientConfig client_config.ClientConfig
-		properties   []byte
 		err          error
 	)
 
-	if properties, err = ioutil.ReadFile(*propertiesFile); err != nil {
-		log.Panicln(err)
-	}
-
-	if clientConfig, err = client_config.NewClientConfigFromJavaProperties(string(properties)); err != nil {
+	if clientConfig, err = client_config.LoadClientConfig(*propertiesFile); err != nil {
 		log.Panicln(err)
 	}
 
@@ -43,9 +40,16 @@ func main() {
 	argument, _ := json.FromJSON(*argumentJSON)
 	functionArgument, _ := json.FromJSON(*functionArgumentJSON)
 
-	var result model.ContractExecutionResult
+	var (
+		result  model.ContractExecutionResult
+		options = client_service.ContractExecutionOptions{PreExecution: *preExecution}
+	)
+
+	if *orderingKeys != "" {
+		options.OrderingKeys = strings.Split(*orderingKeys, ",")
+	}
 
-	if result, err = service.ExecuteContract(*id, argument, functionArgument); err != nil {
+	if result, err = service.ExecuteContractWithOptions(*id, argument, functionArgument, options); err != nil {
 		if clientError, ok := err.(client_error.ClientError); ok {
 			log.Panicf(
 				"%d %s\n",
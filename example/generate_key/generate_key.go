@@ -0,0 +1,61 @@
+package main
+
+import (
+	"crypto/x509/pkix"
+	"flag"
+	"io/ioutil"
+	"log"
+
+	"github.com/scalar-labs/scalardl-go-client-sdk/v3/crypto/keygen"
+)
+
+var (
+	commonName   = flag.String("cn", "", "the common name of the certificate")
+	organization = flag.String("o", "", "the organization of the certificate")
+	keyFile      = flag.String("key", "client-key.pem", "the file to write the private key")
+	csrFile      = flag.String("csr", "client.csr", "the file to write the certificate signing request")
+	certFile     = flag.String("cert", "", "the file to write a self-signed certificate for local development (optional)")
+)
+
+func main() {
+	flag.Parse()
+
+	var (
+		subject    = pkix.Name{CommonName: *commonName}
+		privateKey string
+		csr        string
+		err        error
+	)
+
+	if *organization != "" {
+		subject.Organization = []string{*organization}
+	}
+
+	if privateKey, err = keygen.GenerateKey(); err != nil {
+		log.Panicln(err)
+	}
+
+	if err = ioutil.WriteFile(*keyFile, []byte(privateKey), 0600); err != nil {
+		log.Panicln(err)
+	}
+
+	if csr, err = keygen.CreateCSR(privateKey, subject); err != nil {
+		log.Panicln(err)
+	}
+
+	if err = ioutil.WriteFile(*csrFile, []byte(csr), 0644); err != nil {
+		log.Panicln(err)
+	}
+
+	if *certFile != "" {
+		var cert string
+
+		if cert, err = keygen.SelfSign(privateKey, subject, keygen.DefaultValidity); err != nil {
+			log.Panicln(err)
+		}
+
+		if err = ioutil.WriteFile(*certFile, []byte(cert), 0644); err != nil {
+			log.Panicln(err)
+		}
+	}
+}
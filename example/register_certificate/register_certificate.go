@@ -2,7 +2,6 @@ package main
 
 import (
 	"flag"
-	"io/ioutil"
 	"log"
 
 	client_config "github.com/scalar-labs/scalardl-go-client-sdk/v3/client/config"
@@ -17,15 +16,10 @@ func main() {
 
 	var (
 		clientConfig client_config.ClientConfig
-		properties   []byte
 		err          error
 	)
 
-	if properties, err = ioutil.ReadFile(*propertiesFile); err != nil {
-		log.Panicln(err)
-	}
-
-	if clientConfig, err = client_config.NewClientConfigFromJavaProperties(string(properties)); err != nil {
+	if clientConfig, err = client_config.LoadClientConfig(*propertiesFile); err != nil {
 		log.Panicln(err)
 	}
 
@@ -35,7 +29,7 @@ func main() {
 	}
 	defer service.Close()
 
-	if err = service.RegisterCertificate(); err != nil {
+	if err = service.EnsureCertificate(); err != nil {
 		if clientError, ok := err.(client_error.ClientError); ok {
 			log.Panicf(
 				"%d %s\n",
@@ -2,10 +2,10 @@ package main
 
 import (
 	"flag"
-	"io/ioutil"
 	"log"
-	"os"
+	"strings"
 
+	"github.com/scalar-labs/scalardl-go-client-sdk/v3/classfile"
 	client_config "github.com/scalar-labs/scalardl-go-client-sdk/v3/client/config"
 	client_error "github.com/scalar-labs/scalardl-go-client-sdk/v3/client/error"
 	client_service "github.com/scalar-labs/scalardl-go-client-sdk/v3/client/service"
@@ -14,8 +14,8 @@ import (
 
 var propertiesFile = flag.String("properties", "client.properties", "the properties file")
 var id = flag.String("id", "", "the contract id to register")
-var name = flag.String("name", "", "the binary name of the contract file")
-var contractFile = flag.String("contract", "", "the contract file path")
+var name = flag.String("name", "", "the binary name of the contract, which is required for a jar file and derived from a class file if omitted")
+var contractFile = flag.String("contract", "", "the contract file path (.class or .jar)")
 var contractPropertiesJSON = flag.String("contract_properties", "", "the contract properties (JSON)")
 
 func main() {
@@ -23,15 +23,10 @@ func main() {
 
 	var (
 		clientConfig client_config.ClientConfig
-		properties   []byte
 		err          error
 	)
 
-	if properties, err = ioutil.ReadFile(*propertiesFile); err != nil {
-		log.Panicln(err)
-	}
-
-	if clientConfig, err = client_config.NewClientConfigFromJavaProperties(string(properties)); err != nil {
+	if clientConfig, err = client_config.LoadClientConfig(*propertiesFile); err != nil {
 		log.Panicln(err)
 	}
 
@@ -41,23 +36,26 @@ func main() {
 	}
 	defer service.Close()
 
-	var (
-		contractBytes      []byte
-		contractProperties json.Object
-	)
+	var contractProperties json.Object
+	contractProperties, _ = json.FromJSON(*contractPropertiesJSON)
 
-	if contractBytes, err = os.ReadFile(*contractFile); err != nil {
+	var class classfile.ClassFile
+
+	if strings.HasSuffix(*contractFile, ".jar") {
+		class, err = classfile.ReadFromJar(*contractFile, *name)
+	} else {
+		class, err = classfile.ReadFile(*contractFile)
+	}
+
+	if err != nil {
 		log.Panicln(err)
 	}
 
-	contractProperties, _ = json.FromJSON(*contractPropertiesJSON)
+	if *name != "" && *name != class.BinaryName {
+		log.Panicf("%s defines %s rather than %s\n", *contractFile, class.BinaryName, *name)
+	}
 
-	if err = service.RegisterContract(
-		*id,
-		*name,
-		contractBytes,
-		contractProperties,
-	); err != nil {
+	if err = service.EnsureContract(*id, class.BinaryName, class.Bytes, contractProperties); err != nil {
 		if clientError, ok := err.(client_error.ClientError); ok {
 			log.Panicf(
 				"%d %s\n",
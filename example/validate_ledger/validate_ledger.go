@@ -2,7 +2,6 @@ package main
 
 import (
 	"flag"
-	"io/ioutil"
 	"log"
 
 	client_config "github.com/scalar-labs/scalardl-go-client-sdk/v3/client/config"
@@ -22,15 +21,10 @@ func main() {
 
 	var (
 		clientConfig client_config.ClientConfig
-		properties   []byte
 		err          error
 	)
 
-	if properties, err = ioutil.ReadFile(*propertiesFile); err != nil {
-		log.Panicln(err)
-	}
-
-	if clientConfig, err = client_config.NewClientConfigFromJavaProperties(string(properties)); err != nil {
+	if clientConfig, err = client_config.LoadClientConfig(*propertiesFile); err != nil {
 		log.Panicln(err)
 	}
 
@@ -40,12 +34,9 @@ func main() {
 	}
 	defer service.Close()
 
-	var (
-		argument []interface{} = []interface{}{*assetID, *startAge, *endAge}
-		result   model.LedgerValidationResult
-	)
+	var result model.LedgerValidationResult
 
-	if result, err = service.ValidateLedger(argument...); err != nil {
+	if result, err = service.ValidateLedgerRange(*assetID, *startAge, *endAge); err != nil {
 		if clientError, ok := err.(client_error.ClientError); ok {
 			log.Panicf(
 				"%d %s\n",
@@ -0,0 +1,99 @@
+package gateway
+
+import (
+	"net/http"
+
+	clientError "github.com/scalar-labs/scalardl-go-client-sdk/v3/client/error"
+	"github.com/scalar-labs/scalardl-go-client-sdk/v3/ledger/statuscode"
+)
+
+// ErrorBody is the body of the error responses.
+// StatusCode is the Scalar DL status code, which is omitted for the errors in the gateway, such as a malformed request.
+type ErrorBody struct {
+	StatusCode statuscode.StatusCode `json:"status_code,omitempty"`
+	Error      string                `json:"error"`
+	Message    string                `json:"message"`
+}
+
+// The errors in the gateway.
+const (
+	errorBadRequest       = "BAD_REQUEST"
+	errorForbidden        = "FORBIDDEN"
+	errorNotFound         = "NOT_FOUND"
+	errorMethodNotAllowed = "METHOD_NOT_ALLOWED"
+	errorTooLarge         = "REQUEST_TOO_LARGE"
+	errorUnavailable      = "LEDGER_UNAVAILABLE"
+)
+
+// statusNames are the names of the Scalar DL status codes in the error bodies.
+var statusNames = map[statuscode.StatusCode]string{
+	statuscode.InvalidHash:                  "INVALID_HASH",
+	statuscode.InvalidPrevHash:              "INVALID_PREV_HASH",
+	statuscode.InvalidContract:              "INVALID_CONTRACT",
+	statuscode.InvalidOutput:                "INVALID_OUTPUT",
+	statuscode.InvalidNonce:                 "INVALID_NONCE",
+	statuscode.InconsistentStates:           "INCONSISTENT_STATES",
+	statuscode.InconsistentRequest:          "INCONSISTENT_REQUEST",
+	statuscode.InvalidSignature:             "INVALID_SIGNATURE",
+	statuscode.UnloadableKey:                "UNLOADABLE_KEY",
+	statuscode.UnloadableContract:           "UNLOADABLE_CONTRACT",
+	statuscode.CertificateNotFound:          "CERTIFICATE_NOT_FOUND",
+	statuscode.ContractNotFound:             "CONTRACT_NOT_FOUND",
+	statuscode.CertificateAlreadyRegistered: "CERTIFICATE_ALREADY_REGISTERED",
+	statuscode.ContractAlreadyRegistered:    "CONTRACT_ALREADY_REGISTERED",
+	statuscode.InvalidRequest:               "INVALID_REQUEST",
+	statuscode.ContractContextualError:      "CONTRACT_CONTEXTUAL_ERROR",
+	statuscode.AssetNotFound:                "ASSET_NOT_FOUND",
+	statuscode.FunctionNotFound:             "FUNCTION_NOT_FOUND",
+	statuscode.UnloadableFunction:           "UNLOADABLE_FUNCTION",
+	statuscode.InvalidFunction:              "INVALID_FUNCTION",
+	statuscode.SecretAlreadyRegistered:      "SECRET_ALREADY_REGISTERED",
+	statuscode.DatabaseError:                "DATABASE_ERROR",
+	statuscode.UnknownTransactionStatus:     "UNKNOWN_TRANSACTION_STATUS",
+	statuscode.RuntimeError:                 "RUNTIME_ERROR",
+	statuscode.Unavailable:                  "UNAVAILABLE",
+	statuscode.Conflict:                     "CONFLICT",
+}
+
+// httpStatus maps a Scalar DL status code to an HTTP status code.
+func httpStatus(code statuscode.StatusCode) int {
+	switch code {
+	case statuscode.CertificateNotFound, statuscode.ContractNotFound, statuscode.AssetNotFound, statuscode.FunctionNotFound:
+		return http.StatusNotFound
+	case statuscode.CertificateAlreadyRegistered, statuscode.ContractAlreadyRegistered, statuscode.SecretAlreadyRegistered,
+		statuscode.InvalidNonce, statuscode.Conflict:
+		return http.StatusConflict
+	case statuscode.InvalidSignature, statuscode.UnloadableKey:
+		return http.StatusUnauthorized
+	case statuscode.ContractContextualError:
+		return http.StatusUnprocessableEntity
+	case statuscode.Unavailable:
+		return http.StatusServiceUnavailable
+	}
+
+	switch {
+	case code < 400:
+		// the ledger states are inconsistent or tampered.
+		return http.StatusConflict
+	case code < 500:
+		return http.StatusBadRequest
+	default:
+		return http.StatusBadGateway
+	}
+}
+
+// toErrorBody converts an error from ClientService to the HTTP status and the error body.
+// The errors not from Scalar DL, e.g. the connection failures, are reported as LEDGER_UNAVAILABLE.
+func toErrorBody(err error) (int, ErrorBody) {
+	e, ok := err.(clientError.ClientError)
+	if !ok {
+		return http.StatusBadGateway, ErrorBody{Error: errorUnavailable, Message: err.Error()}
+	}
+
+	var name, found = statusNames[e.StatusCode()]
+	if !found {
+		name = "UNKNOWN"
+	}
+
+	return httpStatus(e.StatusCode()), ErrorBody{StatusCode: e.StatusCode(), Error: name, Message: e.Error()}
+}
@@ -0,0 +1,508 @@
+// Package gateway exposes ClientService through an HTTP/JSON API,
+// so that services in other languages send the requests signed by the Go client:
+//
+//	POST /certificates                     register the certificate, or the secret key for HMAC
+//	POST /contracts                        register a contract
+//	GET  /contracts[/{contract-id}]        list the registered contracts
+//	POST /contracts/{contract-id}/execute  execute a contract
+//	POST /functions                        register a function
+//	POST /assets/{asset-id}/validate       validate an asset, between ?start_age= and ?end_age= if given
+//	GET  /assets/{asset-id}/proof          retrieve the proof of ?age=, the latest one by default
+//	POST /executions/{nonce}/abort         abort a contract execution unless it is committed
+//
+// The request and the response bodies are JSON. The errors are returned with ErrorBody.
+package gateway
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/scalar-labs/scalardl-go-client-sdk/v3/client/service"
+	sdkJSON "github.com/scalar-labs/scalardl-go-client-sdk/v3/json"
+	"github.com/scalar-labs/scalardl-go-client-sdk/v3/ledger/asset"
+	"github.com/scalar-labs/scalardl-go-client-sdk/v3/ledger/statuscode"
+)
+
+// IdentityHeader is the HTTP header that selects the identity of a request by its certificate holder ID or entity ID.
+const IdentityHeader = "X-Scalardl-Cert-Holder-Id"
+
+// DefaultMaxRequestBytes is the default limit of the size of the request bodies.
+const DefaultMaxRequestBytes = 1 << 20
+
+// Options defines the optional parameters of New.
+type Options struct {
+	// Identities are the identities that the requests select by IdentityHeader.
+	// The requests without the header are sent on behalf of the identity of ClientService.
+	Identities []service.Identity
+
+	// Authorize checks if the HTTP request may send the requests on behalf of the certificate holder,
+	// e.g. by the credential in the Authorization header. It is required unless AllowUnauthenticated is set.
+	Authorize func(r *http.Request, certHolderID string) error
+
+	// AllowUnauthenticated allows all the HTTP requests without Authorize,
+	// e.g. when the gateway is only reachable from the same host.
+	AllowUnauthenticated bool
+
+	// MaxRequestBytes limits the size of the request bodies. It is DefaultMaxRequestBytes if it is not positive.
+	MaxRequestBytes int64
+}
+
+// Gateway is an http.Handler that sends the requests to Scalar DL with ClientService.
+type Gateway struct {
+	service    service.ClientService
+	identities map[string]service.Identity
+	options    Options
+}
+
+// New creates Gateway that sends the requests with the ClientService. The ClientService is not closed by Gateway.
+// It fails without Options.Authorize unless Options.AllowUnauthenticated is set,
+// since anyone reaching the gateway could otherwise sign the requests with the identities.
+func New(s service.ClientService, options Options) (*Gateway, error) {
+	if options.Authorize == nil && !options.AllowUnauthenticated {
+		return nil, errors.New("Authorize is required unless AllowUnauthenticated is set")
+	}
+
+	if options.MaxRequestBytes <= 0 {
+		options.MaxRequestBytes = DefaultMaxRequestBytes
+	}
+
+	var identities = map[string]service.Identity{s.Identity().CertHolderID: s.Identity()}
+
+	for _, identity := range options.Identities {
+		identities[identity.CertHolderID] = identity
+	}
+
+	return &Gateway{service: s, identities: identities, options: options}, nil
+}
+
+// httpError is an error in the gateway with the HTTP status and the error name.
+type httpError struct {
+	status int
+	name   string
+	err    error
+}
+
+func (e httpError) Error() string {
+	return e.err.Error()
+}
+
+func badRequest(format string, args ...interface{}) error {
+	return httpError{status: http.StatusBadRequest, name: errorBadRequest, err: fmt.Errorf(format, args...)}
+}
+
+// route is an API of the gateway.
+type route struct {
+	method string
+
+	// pattern is the path segments, where "*" matches any segment and "*?" matches an optional last segment.
+	pattern []string
+
+	handle func(g *Gateway, r *http.Request, client service.ClientService, params []string) (status int, body interface{}, err error)
+}
+
+var routes = []route{
+	{http.MethodPost, []string{"certificates"}, (*Gateway).registerCertificate},
+	{http.MethodPost, []string{"contracts"}, (*Gateway).registerContract},
+	{http.MethodGet, []string{"contracts", "*?"}, (*Gateway).listContracts},
+	{http.MethodPost, []string{"contracts", "*", "execute"}, (*Gateway).executeContract},
+	{http.MethodPost, []string{"functions"}, (*Gateway).registerFunction},
+	{http.MethodPost, []string{"assets", "*", "validate"}, (*Gateway).validateAsset},
+	{http.MethodGet, []string{"assets", "*", "proof"}, (*Gateway).retrieveProof},
+	{http.MethodPost, []string{"executions", "*", "abort"}, (*Gateway).abortExecution},
+}
+
+// match reports whether the path segments match the pattern, and returns the matched segments of the wildcards.
+func (rt route) match(segments []string) (params []string, ok bool) {
+	if len(segments) > len(rt.pattern) {
+		return nil, false
+	}
+
+	for i, p := range rt.pattern {
+		switch {
+		case p == "*?" && i == len(segments):
+			return append(params, ""), true
+		case i >= len(segments):
+			return nil, false
+		case p == "*" || p == "*?":
+			params = append(params, segments[i])
+		case p != segments[i]:
+			return nil, false
+		}
+	}
+
+	return params, true
+}
+
+// ServeHTTP implements http.Handler.
+func (g *Gateway) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	status, body, err := g.serve(w, r)
+	if err != nil {
+		var e httpError
+		if errors.As(err, &e) {
+			writeJSON(w, e.status, ErrorBody{Error: e.name, Message: e.Error()})
+		} else {
+			status, body := toErrorBody(err)
+			writeJSON(w, status, body)
+		}
+
+		return
+	}
+
+	writeJSON(w, status, body)
+}
+
+func (g *Gateway) serve(w http.ResponseWriter, r *http.Request) (int, interface{}, error) {
+	segments, err := splitPath(r.URL)
+	if err != nil {
+		return 0, nil, badRequest("%v", err)
+	}
+
+	var pathMatched bool
+
+	for _, rt := range routes {
+		params, ok := rt.match(segments)
+		if !ok {
+			continue
+		}
+
+		pathMatched = true
+
+		if rt.method != r.Method {
+			continue
+		}
+
+		client, err := g.client(r)
+		if err != nil {
+			return 0, nil, err
+		}
+
+		r.Body = http.MaxBytesReader(w, r.Body, g.options.MaxRequestBytes)
+
+		return rt.handle(g, r, client, params)
+	}
+
+	if pathMatched {
+		return 0, nil, httpError{
+			status: http.StatusMethodNotAllowed,
+			name:   errorMethodNotAllowed,
+			err:    fmt.Errorf("%s is not allowed for %s", r.Method, r.URL.Path),
+		}
+	}
+
+	return 0, nil, httpError{status: http.StatusNotFound, name: errorNotFound, err: fmt.Errorf("%s is not found", r.URL.Path)}
+}
+
+// splitPath splits the path into the unescaped segments, so that the IDs may contain escaped slashes.
+func splitPath(u *url.URL) (segments []string, err error) {
+	for _, s := range strings.Split(strings.Trim(u.EscapedPath(), "/"), "/") {
+		if s == "" {
+			continue
+		}
+
+		if s, err = url.PathUnescape(s); err != nil {
+			return nil, err
+		}
+
+		segments = append(segments, s)
+	}
+
+	return
+}
+
+// client returns ClientService on behalf of the identity selected by the request.
+func (g *Gateway) client(r *http.Request) (client service.ClientService, err error) {
+	var certHolderID = r.Header.Get(IdentityHeader)
+	if certHolderID == "" {
+		certHolderID = g.service.Identity().CertHolderID
+	}
+
+	identity, ok := g.identities[certHolderID]
+	if !ok {
+		return client, httpError{
+			status: http.StatusForbidden,
+			name:   errorForbidden,
+			err:    fmt.Errorf("the identity %s is not served by the gateway", certHolderID),
+		}
+	}
+
+	if g.options.Authorize != nil {
+		if err = g.options.Authorize(r, certHolderID); err != nil {
+			return client, httpError{status: http.StatusForbidden, name: errorForbidden, err: err}
+		}
+	}
+
+	return g.service.WithIdentity(identity), nil
+}
+
+// readBody decodes the JSON request body into the value. An empty body leaves the value as it is.
+func readBody(r *http.Request, v interface{}) error {
+	var decoder = json.NewDecoder(r.Body)
+	decoder.DisallowUnknownFields()
+
+	switch err := decoder.Decode(v); {
+	case err == nil || err == io.EOF:
+		return nil
+	case strings.Contains(err.Error(), "request body too large"):
+		// http.MaxBytesReader doesn't have a type of the error until Go 1.19.
+		return httpError{status: http.StatusRequestEntityTooLarge, name: errorTooLarge, err: err}
+	default:
+		return badRequest("the request body is not valid: %v", err)
+	}
+}
+
+// writeJSON writes the body in JSON with the HTTP status.
+func writeJSON(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+
+	if body != nil {
+		json.NewEncoder(w).Encode(body)
+	}
+}
+
+// registration is the request body of the contract and the function registrations.
+// Bytes is the base64-encoded Java class file.
+type registration struct {
+	ContractID string         `json:"contract_id,omitempty"`
+	FunctionID string         `json:"function_id,omitempty"`
+	BinaryName string         `json:"binary_name"`
+	Bytes      []byte         `json:"bytes"`
+	Properties sdkJSON.Object `json:"properties,omitempty"`
+}
+
+func (g *Gateway) registerCertificate(
+	_ *http.Request,
+	client service.ClientService,
+	_ []string,
+) (int, interface{}, error) {
+	var err error
+
+	if isHmac(client) {
+		err = client.RegisterSecret()
+	} else {
+		err = client.RegisterCertificate()
+	}
+
+	if err != nil {
+		return 0, nil, err
+	}
+
+	return http.StatusCreated, nil, nil
+}
+
+// isHmac reports whether the current key of the identity is a secret key for HMAC authentication.
+func isHmac(client service.ClientService) bool {
+	key, ok := client.Identity().Keyring.Current()
+	return ok && key.SecretKey != ""
+}
+
+func (g *Gateway) registerContract(r *http.Request, client service.ClientService, _ []string) (int, interface{}, error) {
+	var request registration
+	if err := readBody(r, &request); err != nil {
+		return 0, nil, err
+	}
+
+	if request.ContractID == "" || request.BinaryName == "" || len(request.Bytes) == 0 {
+		return 0, nil, badRequest("contract_id, binary_name and bytes are required")
+	}
+
+	if err := client.RegisterContract(request.ContractID, request.BinaryName, request.Bytes, request.Properties); err != nil {
+		return 0, nil, err
+	}
+
+	return http.StatusCreated, nil, nil
+}
+
+func (g *Gateway) listContracts(_ *http.Request, client service.ClientService, params []string) (int, interface{}, error) {
+	contracts, err := client.ListContracts(params[0])
+	if err != nil {
+		return 0, nil, err
+	}
+
+	if contracts == nil {
+		contracts = sdkJSON.Object{}
+	}
+
+	return http.StatusOK, contracts, nil
+}
+
+func (g *Gateway) registerFunction(r *http.Request, client service.ClientService, _ []string) (int, interface{}, error) {
+	var request registration
+	if err := readBody(r, &request); err != nil {
+		return 0, nil, err
+	}
+
+	if request.FunctionID == "" || request.BinaryName == "" || len(request.Bytes) == 0 {
+		return 0, nil, badRequest("function_id, binary_name and bytes are required")
+	}
+
+	if err := client.RegisterFunction(request.FunctionID, request.BinaryName, request.Bytes); err != nil {
+		return 0, nil, err
+	}
+
+	return http.StatusCreated, nil, nil
+}
+
+// execution is the request body of the contract execution.
+type execution struct {
+	Argument         sdkJSON.Object `json:"argument"`
+	FunctionArgument sdkJSON.Object `json:"function_argument,omitempty"`
+	OrderingKeys     []string       `json:"ordering_keys,omitempty"`
+	PreExecution     bool           `json:"pre_execution,omitempty"`
+}
+
+// ExecutionResult is the response body of the contract execution.
+// Nonce identifies the execution to abort it.
+type ExecutionResult struct {
+	Nonce         string         `json:"nonce"`
+	Result        sdkJSON.Object `json:"result"`
+	Proofs        []Proof        `json:"proofs"`
+	AuditorProofs []Proof        `json:"auditor_proofs,omitempty"`
+}
+
+func (g *Gateway) executeContract(r *http.Request, client service.ClientService, params []string) (int, interface{}, error) {
+	var request execution
+	if err := readBody(r, &request); err != nil {
+		return 0, nil, err
+	}
+
+	if request.Argument == nil {
+		request.Argument = sdkJSON.Object{}
+	}
+
+	executed, err := client.ExecuteContractWithOptions(
+		params[0],
+		request.Argument,
+		request.FunctionArgument,
+		service.ContractExecutionOptions{OrderingKeys: request.OrderingKeys, PreExecution: request.PreExecution},
+	)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	return http.StatusOK, ExecutionResult{
+		Nonce:         executed.Nonce,
+		Result:        executed.Result,
+		Proofs:        toProofs(executed.Proofs),
+		AuditorProofs: toProofs(executed.AuditorProofs),
+	}, nil
+}
+
+// ValidationResult is the response body of the asset validation.
+// The validation that detects tampering succeeds with the status code other than 200.
+type ValidationResult struct {
+	StatusCode   statuscode.StatusCode `json:"status_code"`
+	Proof        *Proof                `json:"proof,omitempty"`
+	AuditorProof *Proof                `json:"auditor_proof,omitempty"`
+}
+
+func (g *Gateway) validateAsset(r *http.Request, client service.ClientService, params []string) (int, interface{}, error) {
+	startAge, err := queryInt(r, "start_age", 0)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	endAge, err := queryInt(r, "end_age", service.JavaMaxIntValue)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	if startAge < 0 || endAge < startAge {
+		return 0, nil, badRequest("invalid ages specified")
+	}
+
+	validated, err := client.ValidateLedgerRange(params[0], startAge, endAge)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	return http.StatusOK, ValidationResult{
+		StatusCode:   validated.Code,
+		Proof:        toProof(validated.Proof),
+		AuditorProof: toProof(validated.AuditorProof),
+	}, nil
+}
+
+func (g *Gateway) retrieveProof(r *http.Request, client service.ClientService, params []string) (int, interface{}, error) {
+	age, err := queryInt(r, "age", -1)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	p, err := client.RetrieveAssetProof(params[0], age)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	return http.StatusOK, toProof(p), nil
+}
+
+func (g *Gateway) abortExecution(_ *http.Request, client service.ClientService, params []string) (int, interface{}, error) {
+	state, err := client.AbortExecution(params[0])
+	if err != nil {
+		return 0, nil, err
+	}
+
+	return http.StatusOK, map[string]string{"state": state.String()}, nil
+}
+
+// queryInt returns the integer in the query parameter, or the default value if it is not given.
+func queryInt(r *http.Request, name string, defaultValue int) (int, error) {
+	var value = r.URL.Query().Get(name)
+	if value == "" {
+		return defaultValue, nil
+	}
+
+	i, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, badRequest("%s must be an integer: %s", name, value)
+	}
+
+	return i, nil
+}
+
+// Proof is an asset proof in the response bodies. The binary values are base64-encoded.
+type Proof struct {
+	AssetID   string         `json:"asset_id"`
+	Age       int32          `json:"age"`
+	Nonce     string         `json:"nonce"`
+	Input     sdkJSON.Object `json:"input"`
+	Hash      []byte         `json:"hash"`
+	PrevHash  []byte         `json:"prev_hash,omitempty"`
+	Signature []byte         `json:"signature"`
+}
+
+// toProof converts an asset proof for the response bodies, which is nil for the empty proof.
+func toProof(p asset.Proof) *Proof {
+	if p.Equal(asset.Proof{}) {
+		return nil
+	}
+
+	return &Proof{
+		AssetID:   p.ID,
+		Age:       p.Age,
+		Nonce:     p.Nonce,
+		Input:     p.Input,
+		Hash:      p.Hash,
+		PrevHash:  p.PrevHash,
+		Signature: p.Signature,
+	}
+}
+
+func toProofs(proofs []asset.Proof) []Proof {
+	var converted = make([]Proof, 0, len(proofs))
+
+	for _, p := range proofs {
+		if c := toProof(p); c != nil {
+			converted = append(converted, *c)
+		}
+	}
+
+	return converted
+}
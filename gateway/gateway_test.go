@@ -0,0 +1,186 @@
+package gateway
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/scalar-labs/scalardl-go-client-sdk/v3/client/service"
+	sdkJSON "github.com/scalar-labs/scalardl-go-client-sdk/v3/json"
+	"github.com/scalar-labs/scalardl-go-client-sdk/v3/scalardltest"
+)
+
+func newGateway(t *testing.T, options Options) (*scalardltest.Server, *httptest.Server) {
+	server, s := scalardltest.NewTestClient(t, scalardltest.Options{})
+
+	return server, serve(t, s, options)
+}
+
+// serve starts the gateway of the client service.
+func serve(t *testing.T, s service.ClientService, options Options) *httptest.Server {
+	g, err := New(s, options)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var h = httptest.NewServer(g)
+	t.Cleanup(h.Close)
+
+	return h
+}
+
+// call sends the request to the gateway, and decodes the response body.
+func call(t *testing.T, h *httptest.Server, method string, path string, body string, header http.Header) (int, map[string]interface{}) {
+	request, err := http.NewRequest(method, h.URL+path, strings.NewReader(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for k, v := range header {
+		request.Header[k] = v
+	}
+
+	response, err := h.Client().Do(request)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer response.Body.Close()
+
+	var decoded map[string]interface{}
+	json.NewDecoder(response.Body).Decode(&decoded)
+
+	return response.StatusCode, decoded
+}
+
+func TestNew(t *testing.T) {
+	if _, err := New(service.ClientService{}, Options{}); err == nil {
+		t.Errorf("should require Authorize")
+	}
+
+	if _, err := New(service.ClientService{}, Options{AllowUnauthenticated: true}); err != nil {
+		t.Errorf("should allow the unauthenticated requests explicitly: %v", err)
+	}
+}
+
+func TestGateway(t *testing.T) {
+	server, h := newGateway(t, Options{AllowUnauthenticated: true})
+
+	status, body := call(t, h, "POST", "/certificates", "", nil)
+	if status != http.StatusConflict || body["status_code"] != float64(405) || body["error"] != "CERTIFICATE_ALREADY_REGISTERED" {
+		t.Errorf("should return the error body of the status code: %d %v", status, body)
+	}
+
+	status, body = call(t, h, "POST", "/contracts", `{"contract_id": "another", "binary_name": "com.example.Counter", "bytes": "yv4="}`, nil)
+	if status != http.StatusCreated {
+		t.Errorf("should register the contract: %d %v", status, body)
+	}
+
+	if status, body = call(t, h, "GET", "/contracts", "", nil); status != http.StatusOK || body["counter"] == nil || body["another"] == nil {
+		t.Errorf("should list the contracts: %d %v", status, body)
+	}
+
+	status, body = call(t, h, "POST", "/contracts/counter/execute", `{"argument": {"asset_id": "a", "amount": 10}}`, nil)
+	if status != http.StatusOK || fmt.Sprint(body["result"]) != "map[balance:10]" || len(body["proofs"].([]interface{})) != 1 {
+		t.Fatalf("should execute the contract: %d %v", status, body)
+	}
+
+	var nonce = body["nonce"].(string)
+
+	if status, body = call(t, h, "GET", "/assets/a/proof?age=0", "", nil); status != http.StatusOK || body["nonce"] != nonce {
+		t.Errorf("should retrieve the proof: %d %v", status, body)
+	}
+
+	if status, body = call(t, h, "POST", "/executions/"+nonce+"/abort", "", nil); status != http.StatusOK || body["state"] != "COMMITTED" {
+		t.Errorf("should not abort the committed execution: %d %v", status, body)
+	}
+
+	if status, body = call(t, h, "POST", "/assets/a/validate", "", nil); status != http.StatusOK || body["status_code"] != float64(200) {
+		t.Errorf("should validate the asset: %d %v", status, body)
+	}
+
+	if err := server.TamperAsset("a", 0, sdkJSON.Object{"balance": 1000}); err != nil {
+		t.Fatal(err)
+	}
+
+	if status, body = call(t, h, "POST", "/assets/a/validate?start_age=0&end_age=0", "", nil); status != http.StatusOK || body["status_code"] != float64(300) {
+		t.Errorf("should report the tampered asset: %d %v", status, body)
+	}
+
+	for _, tc := range []struct {
+		method   string
+		path     string
+		body     string
+		status   int
+		expected string
+	}{
+		{"POST", "/contracts/missing/execute", `{"argument": {}}`, http.StatusNotFound, "CONTRACT_NOT_FOUND"},
+		{"GET", "/assets/missing/proof", "", http.StatusNotFound, "ASSET_NOT_FOUND"},
+		{"POST", "/contracts/counter/execute", `{"argument": [1]}`, http.StatusBadRequest, "BAD_REQUEST"},
+		{"POST", "/contracts/counter/execute", `{"unknown": 1}`, http.StatusBadRequest, "BAD_REQUEST"},
+		{"POST", "/assets/a/validate?start_age=x", "", http.StatusBadRequest, "BAD_REQUEST"},
+		{"GET", "/contracts/counter/execute", "", http.StatusMethodNotAllowed, "METHOD_NOT_ALLOWED"},
+		{"GET", "/unknown", "", http.StatusNotFound, "NOT_FOUND"},
+	} {
+		if status, body = call(t, h, tc.method, tc.path, tc.body, nil); status != tc.status || body["error"] != tc.expected {
+			t.Errorf("should fail for %s %s with %s: %d %v", tc.method, tc.path, tc.expected, status, body)
+		}
+	}
+}
+
+func TestGateway_Identities(t *testing.T) {
+	server, s := scalardltest.NewTestClient(t, scalardltest.Options{})
+
+	c, err := server.NewClientConfig("bob")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bob, err := service.NewIdentityFromConfig(c)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var h = serve(t, s, Options{
+		Identities: []service.Identity{bob},
+		Authorize: func(r *http.Request, certHolderID string) error {
+			if r.Header.Get("Authorization") != "Bearer "+certHolderID {
+				return fmt.Errorf("%s is not authorized", certHolderID)
+			}
+
+			return nil
+		},
+		MaxRequestBytes: 64,
+	})
+
+	var asBob = http.Header{IdentityHeader: {"bob"}, "Authorization": {"Bearer bob"}}
+
+	if status, body := call(t, h, "POST", "/certificates", "", asBob); status != http.StatusCreated {
+		t.Errorf("should register the certificate of the selected identity: %d %v", status, body)
+	}
+
+	if status, body := call(t, h, "GET", "/contracts", "", asBob); status != http.StatusOK || body["counter"] != nil {
+		t.Errorf("should sign the request on behalf of the selected identity: %d %v", status, body)
+	}
+
+	if status, body := call(t, h, "GET", "/contracts", "", http.Header{"Authorization": {"Bearer alice"}}); status != http.StatusOK ||
+		body["counter"] == nil {
+		t.Errorf("should use the default identity without the header: %d %v", status, body)
+	}
+
+	if status, body := call(t, h, "GET", "/contracts", "", http.Header{IdentityHeader: {"bob"}}); status != http.StatusForbidden {
+		t.Errorf("should reject the unauthorized request: %d %v", status, body)
+	}
+
+	if status, body := call(t, h, "GET", "/contracts", "", http.Header{IdentityHeader: {"carol"}}); status != http.StatusForbidden {
+		t.Errorf("should reject the unknown identity: %d %v", status, body)
+	}
+
+	var large = `{"argument": {"asset_id": "` + strings.Repeat("a", 100) + `"}}`
+	if status, body := call(t, h, "POST", "/contracts/counter/execute", large, asBob); status != http.StatusRequestEntityTooLarge ||
+		body["error"] != "REQUEST_TOO_LARGE" {
+		t.Errorf("should limit the size of the request body: %d %v", status, body)
+	}
+}
@@ -0,0 +1,57 @@
+package model
+
+// RegistrationStatus tells how a registration ended in a server.
+type RegistrationStatus int
+
+const (
+	// NotAttempted indicates that the registration was not sent to the server, e.g. Auditor is disabled.
+	NotAttempted RegistrationStatus = iota
+
+	// Registered indicates that the registration has succeeded.
+	Registered
+
+	// AlreadyRegistered indicates that the same item has been registered before.
+	AlreadyRegistered
+
+	// Failed indicates that the registration has failed for other reasons.
+	Failed
+)
+
+// String returns the name of the registration status.
+func (s RegistrationStatus) String() string {
+	switch s {
+	case NotAttempted:
+		return "NotAttempted"
+	case Registered:
+		return "Registered"
+	case AlreadyRegistered:
+		return "AlreadyRegistered"
+	case Failed:
+		return "Failed"
+	default:
+		return "Unknown"
+	}
+}
+
+// RegistrationOutcome defines the registration status in a server along with the error if it is not Registered.
+type RegistrationOutcome struct {
+	Status RegistrationStatus
+	Err    error
+}
+
+// CertificateRegistrationResult defines the outcomes of a certificate registration in Ledger and Auditor.
+type CertificateRegistrationResult struct {
+	Ledger  RegistrationOutcome
+	Auditor RegistrationOutcome
+}
+
+// IsComplete checks if the certificate is available in all the servers it has been sent to,
+// no matter if it is newly registered or already registered.
+func (r CertificateRegistrationResult) IsComplete() bool {
+	return r.Ledger.Status != Failed && r.Auditor.Status != Failed
+}
+
+// IsNewlyRegistered checks if the certificate is newly registered in any of the servers.
+func (r CertificateRegistrationResult) IsNewlyRegistered() bool {
+	return r.Ledger.Status == Registered || r.Auditor.Status == Registered
+}
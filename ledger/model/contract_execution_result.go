@@ -9,8 +9,12 @@ import (
 
 // ContractExecutionResult defines the result of a contract execution.
 // It contains the result of the contract execution along with a list of asset proofs from Ledger and Auditor.
+// RawResult is the result in JSON as returned by Ledger, where the numbers are not converted to float64 as in Result.
+// Nonce is the nonce the execution is sent with, which AbortExecution takes.
 type ContractExecutionResult struct {
 	Result        json.Object
+	RawResult     string
+	Nonce         string
 	Proofs        []asset.Proof
 	AuditorProofs []asset.Proof
 }
@@ -0,0 +1,19 @@
+package model
+
+// DeploymentReport tells what a deployment changed in Scalar DL networks.
+// The items already registered before the deployment are listed separately from the newly registered ones.
+// Functions are listed in UpsertedFunctions since Scalar DL overwrites them,
+// so it can't be told whether they changed.
+type DeploymentReport struct {
+	CertificateRegistered        bool
+	CertificateAlreadyRegistered bool
+	RegisteredContracts          []string
+	AlreadyRegisteredContracts   []string
+	UpsertedFunctions            []string
+}
+
+// HasChanges checks if the deployment registered the certificate or a contract.
+// The upserted functions are not counted as changes.
+func (r DeploymentReport) HasChanges() bool {
+	return r.CertificateRegistered || len(r.RegisteredContracts) > 0
+}
@@ -0,0 +1,30 @@
+package model
+
+import (
+	"github.com/scalar-labs/scalardl-go-client-sdk/v3/ledger/asset"
+	"github.com/scalar-labs/scalardl-go-client-sdk/v3/ledger/statuscode"
+)
+
+// LedgersValidationReport summarizes the validation of multiple assets.
+// OK lists the assets that are validated successfully, and Failures lists the others.
+// Both of them keep the order of the requested assets.
+type LedgersValidationReport struct {
+	OK       []string
+	Failures []LedgerValidationFailure
+}
+
+// LedgerValidationFailure describes why an asset is not validated successfully.
+// Code is the status code returned by Scalar DL networks.
+// It is zero if the validation failed without a status code, e.g. a connection error, and Err tells the reason.
+type LedgerValidationFailure struct {
+	AssetID      string
+	Code         statuscode.StatusCode
+	Proof        asset.Proof
+	AuditorProof asset.Proof
+	Err          error
+}
+
+// IsOK checks if all the assets in the report are validated successfully.
+func (r LedgersValidationReport) IsOK() bool {
+	return len(r.Failures) == 0
+}
@@ -211,3 +211,80 @@ func TestContractExecutionResult_Equal(t *testing.T) {
 		t.Errorf("two differenct ContractExecutionResult should not be equal")
 	}
 }
+
+func TestLedgersValidationReport_IsOK(t *testing.T) {
+	if !(LedgersValidationReport{OK: []string{"foo", "bar"}}).IsOK() {
+		t.Errorf("should be OK without failures")
+	}
+
+	var report = LedgersValidationReport{
+		OK: []string{"foo"},
+		Failures: []LedgerValidationFailure{{
+			AssetID: "bar",
+			Code:    statuscode.InconsistentStates,
+		}},
+	}
+
+	if report.IsOK() {
+		t.Errorf("should not be OK with failures")
+	}
+}
+
+func TestDeploymentReport_HasChanges(t *testing.T) {
+	var report = DeploymentReport{
+		CertificateAlreadyRegistered: true,
+		AlreadyRegisteredContracts:   []string{"foo"},
+	}
+
+	if report.HasChanges() {
+		t.Errorf("should not have changes when everything is already registered")
+	}
+
+	report.UpsertedFunctions = []string{"bar"}
+
+	if report.HasChanges() {
+		t.Errorf("should not count the upserted functions as changes")
+	}
+
+	report.RegisteredContracts = []string{"baz"}
+
+	if !report.HasChanges() {
+		t.Errorf("should have changes when a contract is registered")
+	}
+}
+
+func TestCertificateRegistrationResult(t *testing.T) {
+	var halfRegistered = CertificateRegistrationResult{
+		Ledger:  RegistrationOutcome{Status: Registered},
+		Auditor: RegistrationOutcome{Status: Failed},
+	}
+
+	if halfRegistered.IsComplete() {
+		t.Errorf("should not be complete if the registration failed in Auditor")
+	}
+
+	if !halfRegistered.IsNewlyRegistered() {
+		t.Errorf("should be newly registered if the registration succeeded in Ledger")
+	}
+
+	var repaired = CertificateRegistrationResult{
+		Ledger:  RegistrationOutcome{Status: AlreadyRegistered},
+		Auditor: RegistrationOutcome{Status: Registered},
+	}
+
+	if !repaired.IsComplete() {
+		t.Errorf("should be complete if the certificate is available in both Ledger and Auditor")
+	}
+
+	var withoutAuditor = CertificateRegistrationResult{
+		Ledger: RegistrationOutcome{Status: AlreadyRegistered},
+	}
+
+	if !withoutAuditor.IsComplete() || withoutAuditor.IsNewlyRegistered() {
+		t.Errorf("should be complete but not newly registered")
+	}
+
+	if Failed.String() != "Failed" || NotAttempted.String() != "NotAttempted" {
+		t.Errorf("should return the name of the status")
+	}
+}
@@ -0,0 +1,26 @@
+package model
+
+// TransactionState defines the state of a contract execution in Ledger.
+type TransactionState int32
+
+// The states of a contract execution.
+const (
+	TransactionStateUnspecified TransactionState = 0
+	TransactionStateCommitted   TransactionState = 1
+	TransactionStateAborted     TransactionState = 2
+	TransactionStateUnknown     TransactionState = 3
+)
+
+// String returns the name of the state.
+func (s TransactionState) String() string {
+	switch s {
+	case TransactionStateCommitted:
+		return "COMMITTED"
+	case TransactionStateAborted:
+		return "ABORTED"
+	case TransactionStateUnknown:
+		return "UNKNOWN"
+	default:
+		return "UNSPECIFIED"
+	}
+}
@@ -67,6 +67,9 @@ const (
 	// InvalidFunction indicates that the given function is invalid.
 	InvalidFunction = 412
 
+	// SecretAlreadyRegistered indicates that the given secret key is already registered.
+	SecretAlreadyRegistered = 413
+
 	// DatabaseError indicates that the system encountered a database error such as IO error.
 	DatabaseError = 500
 
@@ -9,55 +9,105 @@ import (
 
 // SignWith signs ContractRegistrationRequest with the given signer and fill the signature.
 func (r *ContractRegistrationRequest) SignWith(signer crypto.Signer) (err error) {
+	r.Signature, err = signer.Sign(r.serialize())
+
+	return
+}
+
+// VerifyWith checks if the signature of ContractRegistrationRequest can be verified by the serialized value.
+// It can tell if a registered contract is the same as the one in this request.
+func (r *ContractRegistrationRequest) VerifyWith(verifier crypto.Verifier) bool {
+	return verifier.Verify(r.serialize(), r.GetSignature())
+}
+
+func (r *ContractRegistrationRequest) serialize() (serialized []byte) {
 	var certVersionBytes []byte = make([]byte, unsafe.Sizeof(r.GetCertVersion()))
 	binary.BigEndian.PutUint32(certVersionBytes, r.GetCertVersion())
 
-	var signing []byte
-	signing = append(signing, []byte(r.GetContractId())...)
-	signing = append(signing, []byte(r.GetContractBinaryName())...)
-	signing = append(signing, r.GetContractByteCode()...)
-	signing = append(signing, []byte(r.GetContractProperties())...)
-	signing = append(signing, []byte(r.GetCertHolderId())...)
-	signing = append(signing, certVersionBytes...)
-
-	r.Signature, err = signer.Sign(signing)
+	serialized = append(serialized, []byte(r.GetContractId())...)
+	serialized = append(serialized, []byte(r.GetContractBinaryName())...)
+	serialized = append(serialized, r.GetContractByteCode()...)
+	serialized = append(serialized, []byte(r.GetContractProperties())...)
+	serialized = append(serialized, []byte(r.GetCertHolderId())...)
+	serialized = append(serialized, certVersionBytes...)
 
 	return
 }
 
 // SignWith signs ContractsListingRequest with the given signer and fill the signature.
 func (r *ContractsListingRequest) SignWith(signer crypto.Signer) (err error) {
+	r.Signature, err = signer.Sign(r.serialize())
+
+	return
+}
+
+// VerifyWith checks if the signature of ContractsListingRequest can be verified by the serialized value.
+func (r *ContractsListingRequest) VerifyWith(verifier crypto.Verifier) bool {
+	return verifier.Verify(r.serialize(), r.GetSignature())
+}
+
+func (r *ContractsListingRequest) serialize() (serialized []byte) {
 	var certVersionBytes []byte = make([]byte, unsafe.Sizeof(r.GetCertVersion()))
 	binary.BigEndian.PutUint32(certVersionBytes, r.GetCertVersion())
 
-	var signing []byte
-	signing = append(signing, []byte(r.GetContractId())...)
-	signing = append(signing, []byte(r.GetCertHolderId())...)
-	signing = append(signing, certVersionBytes...)
-
-	r.Signature, err = signer.Sign(signing)
+	serialized = append(serialized, []byte(r.GetContractId())...)
+	serialized = append(serialized, []byte(r.GetCertHolderId())...)
+	serialized = append(serialized, certVersionBytes...)
 
 	return
 }
 
 // SignWith signs ContractExecutionRequest with the given signer and fill the signature.
 func (r *ContractExecutionRequest) SignWith(signer crypto.Signer) (err error) {
+	r.Signature, err = signer.Sign(r.serialize())
+
+	return
+}
+
+// VerifyWith checks if the signature of ContractExecutionRequest can be verified by the serialized value.
+func (r *ContractExecutionRequest) VerifyWith(verifier crypto.Verifier) bool {
+	return verifier.Verify(r.serialize(), r.GetSignature())
+}
+
+// VerifyAuditorSignatureWith checks if the signature given by Auditor to order the execution
+// can be verified by the serialized value.
+func (r *ContractExecutionRequest) VerifyAuditorSignatureWith(verifier crypto.Verifier) bool {
+	return verifier.Verify(r.serialize(), r.GetAuditorSignature())
+}
+
+// SignAsAuditorWith signs ContractExecutionRequest with the given signer of Auditor
+// and fill the auditor signature. It is what Auditor does to order an execution.
+func (r *ContractExecutionRequest) SignAsAuditorWith(signer crypto.Signer) (err error) {
+	r.AuditorSignature, err = signer.Sign(r.serialize())
+
+	return
+}
+
+func (r *ContractExecutionRequest) serialize() (serialized []byte) {
 	var certVersionBytes []byte = make([]byte, unsafe.Sizeof(r.GetCertVersion()))
 	binary.BigEndian.PutUint32(certVersionBytes, r.GetCertVersion())
 
-	var signing []byte
-	signing = append(signing, []byte(r.GetContractId())...)
-	signing = append(signing, []byte(r.GetContractArgument())...)
-	signing = append(signing, []byte(r.GetCertHolderId())...)
-	signing = append(signing, certVersionBytes...)
-
-	r.Signature, err = signer.Sign(signing)
+	serialized = append(serialized, []byte(r.GetContractId())...)
+	serialized = append(serialized, []byte(r.GetContractArgument())...)
+	serialized = append(serialized, []byte(r.GetCertHolderId())...)
+	serialized = append(serialized, certVersionBytes...)
 
 	return
 }
 
 // SignWith signs LedgerValidationRequest with the given signer and fill the signature
 func (r *LedgerValidationRequest) SignWith(signer crypto.Signer) (err error) {
+	r.Signature, err = signer.Sign(r.serialize())
+
+	return
+}
+
+// VerifyWith checks if the signature of LedgerValidationRequest can be verified by the serialized value.
+func (r *LedgerValidationRequest) VerifyWith(verifier crypto.Verifier) bool {
+	return verifier.Verify(r.serialize(), r.GetSignature())
+}
+
+func (r *LedgerValidationRequest) serialize() (serialized []byte) {
 	var (
 		startAgeBytes    []byte = make([]byte, unsafe.Sizeof(r.GetStartAge()))
 		endAgeBytes      []byte = make([]byte, unsafe.Sizeof(r.GetEndAge()))
@@ -68,35 +118,51 @@ func (r *LedgerValidationRequest) SignWith(signer crypto.Signer) (err error) {
 	binary.BigEndian.PutUint32(endAgeBytes, r.GetEndAge())
 	binary.BigEndian.PutUint32(certVersionBytes, r.GetCertVersion())
 
-	var signing []byte
-	signing = append(signing, []byte(r.GetAssetId())...)
-	signing = append(signing, startAgeBytes...)
-	signing = append(signing, endAgeBytes...)
-	signing = append(signing, []byte(r.GetCertHolderId())...)
-	signing = append(signing, certVersionBytes...)
-
-	r.Signature, err = signer.Sign(signing)
+	serialized = append(serialized, []byte(r.GetAssetId())...)
+	serialized = append(serialized, startAgeBytes...)
+	serialized = append(serialized, endAgeBytes...)
+	serialized = append(serialized, []byte(r.GetCertHolderId())...)
+	serialized = append(serialized, certVersionBytes...)
 
 	return
 }
 
 // SignWith signs LedgersValidationRequest with the given signer and fill the signature.
 func (r *LedgersValidationRequest) SignWith(signer crypto.Signer) (err error) {
+	r.Signature, err = signer.Sign(r.serialize())
+
+	return
+}
+
+// VerifyWith checks if the signature of LedgersValidationRequest can be verified by the serialized value.
+func (r *LedgersValidationRequest) VerifyWith(verifier crypto.Verifier) bool {
+	return verifier.Verify(r.serialize(), r.GetSignature())
+}
+
+func (r *LedgersValidationRequest) serialize() (serialized []byte) {
 	var certVersionBytes []byte = make([]byte, unsafe.Sizeof(r.GetCertVersion()))
 	binary.BigEndian.PutUint32(certVersionBytes, r.GetCertVersion())
 
-	var signing []byte
-	signing = append(signing, []byte(r.GetAssetId())...)
-	signing = append(signing, []byte(r.GetCertHolderId())...)
-	signing = append(signing, certVersionBytes...)
-
-	r.Signature, err = signer.Sign(signing)
+	serialized = append(serialized, []byte(r.GetAssetId())...)
+	serialized = append(serialized, []byte(r.GetCertHolderId())...)
+	serialized = append(serialized, certVersionBytes...)
 
 	return
 }
 
 // SignWith signs AssetProofRetrievalRequest with the given signer and fill the signature.
 func (r *AssetProofRetrievalRequest) SignWith(signer crypto.Signer) (err error) {
+	r.Signature, err = signer.Sign(r.serialize())
+
+	return
+}
+
+// VerifyWith checks if the signature of AssetProofRetrievalRequest can be verified by the serialized value.
+func (r *AssetProofRetrievalRequest) VerifyWith(verifier crypto.Verifier) bool {
+	return verifier.Verify(r.serialize(), r.GetSignature())
+}
+
+func (r *AssetProofRetrievalRequest) serialize() (serialized []byte) {
 	var (
 		ageBytes         []byte = make([]byte, unsafe.Sizeof(r.GetAge()))
 		certVersionBytes []byte = make([]byte, unsafe.Sizeof(r.GetCertVersion()))
@@ -105,28 +171,33 @@ func (r *AssetProofRetrievalRequest) SignWith(signer crypto.Signer) (err error)
 	binary.BigEndian.PutUint32(ageBytes, uint32(r.GetAge()))
 	binary.BigEndian.PutUint32(certVersionBytes, r.GetCertVersion())
 
-	var signing []byte
-	signing = append(signing, []byte(r.GetAssetId())...)
-	signing = append(signing, ageBytes...)
-	signing = append(signing, []byte(r.GetCertHolderId())...)
-	signing = append(signing, certVersionBytes...)
-
-	r.Signature, err = signer.Sign(signing)
+	serialized = append(serialized, []byte(r.GetAssetId())...)
+	serialized = append(serialized, ageBytes...)
+	serialized = append(serialized, []byte(r.GetCertHolderId())...)
+	serialized = append(serialized, certVersionBytes...)
 
 	return
 }
 
 // SignWith signs ExecutionAbortRequest with the given signer and fill the signature.
 func (r *ExecutionAbortRequest) SignWith(signer crypto.Signer) (err error) {
+	r.Signature, err = signer.Sign(r.serialize())
+
+	return
+}
+
+// VerifyWith checks if the signature of ExecutionAbortRequest can be verified by the serialized value.
+func (r *ExecutionAbortRequest) VerifyWith(verifier crypto.Verifier) bool {
+	return verifier.Verify(r.serialize(), r.GetSignature())
+}
+
+func (r *ExecutionAbortRequest) serialize() (serialized []byte) {
 	var certVersionBytes []byte = make([]byte, unsafe.Sizeof(r.GetCertVersion()))
 	binary.BigEndian.PutUint32(certVersionBytes, r.GetCertVersion())
 
-	var signing []byte
-	signing = append(signing, []byte(r.GetNonce())...)
-	signing = append(signing, []byte(r.GetCertHolderId())...)
-	signing = append(signing, certVersionBytes...)
-
-	r.Signature, err = signer.Sign(signing)
+	serialized = append(serialized, []byte(r.GetNonce())...)
+	serialized = append(serialized, []byte(r.GetCertHolderId())...)
+	serialized = append(serialized, certVersionBytes...)
 
 	return
 }
@@ -14,6 +14,24 @@ XYWdrgo0Y3eXEhvK0lsURO9N0nrPiQWT4A==
 -----END EC PRIVATE KEY-----
 `
 
+const testCert = `-----BEGIN CERTIFICATE-----
+MIICizCCAjKgAwIBAgIUMEUDTdWsQpftFkqs6bCd6U++4nEwCgYIKoZIzj0EAwIw
+bzELMAkGA1UEBhMCSlAxDjAMBgNVBAgTBVRva3lvMQ4wDAYDVQQHEwVUb2t5bzEf
+MB0GA1UEChMWU2FtcGxlIEludGVybWVkaWF0ZSBDQTEfMB0GA1UEAxMWU2FtcGxl
+IEludGVybWVkaWF0ZSBDQTAeFw0xODA5MTAwODA3MDBaFw0yMTA5MDkwODA3MDBa
+MEUxCzAJBgNVBAYTAkFVMRMwEQYDVQQIEwpTb21lLVN0YXRlMSEwHwYDVQQKExhJ
+bnRlcm5ldCBXaWRnaXRzIFB0eSBMdGQwWTATBgcqhkjOPQIBBggqhkjOPQMBBwNC
+AAQEa6Gq6bKHsFU2pw0oBBCKkMaihSlRG97Z07rqlAKCO1J+7uUlXbRdhZ2uCjRj
+d5cSG8rSWxRE703Ses+JBZPgo4HVMIHSMA4GA1UdDwEB/wQEAwIFoDATBgNVHSUE
+DDAKBggrBgEFBQcDAjAMBgNVHRMBAf8EAjAAMB0GA1UdDgQWBBRDd2MS9Ndo68PJ
+y9K/RNY6syZW0zAfBgNVHSMEGDAWgBR+Y+v8yByDNp39G7trYrTfZ0UjJzAxBggr
+BgEFBQcBAQQlMCMwIQYIKwYBBQUHMAGGFWh0dHA6Ly9sb2NhbGhvc3Q6ODg4OTAq
+BgNVHR8EIzAhMB+gHaAbhhlodHRwOi8vbG9jYWxob3N0Ojg4ODgvY3JsMAoGCCqG
+SM49BAMCA0cAMEQCIC/Bo4oNU6yHFLJeme5ApxoNdyu3rWyiqWPxJmJAr9L0AiBl
+Gc/v+yh4dHIDhCrimajTQAYOG9n0kajULI70Gg7TNw==
+-----END CERTIFICATE-----
+`
+
 func TestContractRegistrationRequest_SignWith(t *testing.T) {
 	var (
 		signer  crypto.Signer
@@ -41,6 +59,75 @@ func TestContractRegistrationRequest_SignWith(t *testing.T) {
 	}
 }
 
+func TestContractRegistrationRequest_VerifyWith(t *testing.T) {
+	var (
+		signer   crypto.Signer
+		verifier crypto.Verifier
+		err      error
+		request  ContractRegistrationRequest = ContractRegistrationRequest{
+			ContractId:         "TestContract",
+			ContractBinaryName: "com.example.TestContract",
+			ContractByteCode:   []byte{0xCA, 0xFE},
+			ContractProperties: "{}",
+			CertHolderId:       "tester",
+			CertVersion:        1,
+		}
+	)
+
+	if signer, err = crypto.NewEcdsaSha256Signer([]byte(testKey)); err != nil {
+		t.Errorf("should get a Signer")
+	}
+
+	if verifier, err = crypto.NewEcdsaSha256Verifier([]byte(testCert)); err != nil {
+		t.Errorf("should get a Verifier")
+	}
+
+	if err = request.SignWith(signer); err != nil {
+		t.Errorf("should be able to sign")
+	}
+
+	if !request.VerifyWith(verifier) {
+		t.Errorf("signature should be verified")
+	}
+
+	request.ContractByteCode = []byte{0xBA, 0xBE}
+
+	if request.VerifyWith(verifier) {
+		t.Errorf("signature should not be verified with a different byte code")
+	}
+}
+
+func TestContractRegistrationRequest_SignWithHmac(t *testing.T) {
+	var (
+		signer, _   = crypto.NewHmacSha256Signer("secret")
+		verifier, _ = crypto.NewHmacSha256Verifier("secret")
+		other, _    = crypto.NewHmacSha256Verifier("other")
+		request     = ContractRegistrationRequest{
+			ContractId:         "TestContract",
+			ContractBinaryName: "com.example.TestContract",
+			ContractByteCode:   []byte{0xCA, 0xFE},
+			CertHolderId:       "tester",
+			CertVersion:        1,
+		}
+	)
+
+	if err := request.SignWith(signer); err != nil {
+		t.Errorf("should be able to sign")
+	}
+
+	if len(request.Signature) != 32 {
+		t.Errorf("should be signed with HMAC-SHA256")
+	}
+
+	if !request.VerifyWith(verifier) {
+		t.Errorf("signature should be verified")
+	}
+
+	if request.VerifyWith(other) {
+		t.Errorf("signature should not be verified with a different secret key")
+	}
+}
+
 func TestContractsListingRequest_SignWith(t *testing.T) {
 	var (
 		signer  crypto.Signer
@@ -90,6 +177,54 @@ func TestContractExecutionRequest_SignWith(t *testing.T) {
 	}
 }
 
+func TestContractExecutionRequest_VerifyWith(t *testing.T) {
+	var (
+		signer   crypto.Signer
+		verifier crypto.Verifier
+		err      error
+		request  ContractExecutionRequest = ContractExecutionRequest{
+			ContractId:       "TestContract",
+			ContractArgument: `{"foo":"bar"}`,
+			CertHolderId:     "tester",
+			CertVersion:      1,
+		}
+	)
+
+	if signer, err = crypto.NewEcdsaSha256Signer([]byte(testKey)); err != nil {
+		t.Errorf("should get a Signer")
+	}
+
+	if verifier, err = crypto.NewEcdsaSha256Verifier([]byte(testCert)); err != nil {
+		t.Errorf("should get a Verifier")
+	}
+
+	if err = request.SignWith(signer); err != nil {
+		t.Errorf("should be able to sign")
+	}
+
+	if !request.VerifyWith(verifier) {
+		t.Errorf("signature should be verified")
+	}
+
+	if request.VerifyAuditorSignatureWith(verifier) {
+		t.Errorf("auditor signature should not be verified before it is filled")
+	}
+
+	if err = request.SignAsAuditorWith(signer); err != nil {
+		t.Errorf("should be able to sign as Auditor")
+	}
+
+	if !request.VerifyAuditorSignatureWith(verifier) {
+		t.Errorf("auditor signature should be verified")
+	}
+
+	request.ContractArgument = `{"foo":"baz"}`
+
+	if request.VerifyWith(verifier) || request.VerifyAuditorSignatureWith(verifier) {
+		t.Errorf("signatures should not be verified with a different argument")
+	}
+}
+
 func TestLedgerValidationRequest_SignWith(t *testing.T) {
 	var (
 		signer  crypto.Signer
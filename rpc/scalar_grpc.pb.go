@@ -286,6 +286,7 @@ var Ledger_ServiceDesc = grpc.ServiceDesc{
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type LedgerPrivilegedClient interface {
 	RegisterCert(ctx context.Context, in *CertificateRegistrationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	RegisterSecret(ctx context.Context, in *SecretRegistrationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	RegisterFunction(ctx context.Context, in *FunctionRegistrationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	RetrieveState(ctx context.Context, in *StateRetrievalRequest, opts ...grpc.CallOption) (*StateRetrievalResponse, error)
 }
@@ -307,6 +308,15 @@ func (c *ledgerPrivilegedClient) RegisterCert(ctx context.Context, in *Certifica
 	return out, nil
 }
 
+func (c *ledgerPrivilegedClient) RegisterSecret(ctx context.Context, in *SecretRegistrationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/rpc.LedgerPrivileged/RegisterSecret", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *ledgerPrivilegedClient) RegisterFunction(ctx context.Context, in *FunctionRegistrationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
 	err := c.cc.Invoke(ctx, "/rpc.LedgerPrivileged/RegisterFunction", in, out, opts...)
@@ -330,6 +340,7 @@ func (c *ledgerPrivilegedClient) RetrieveState(ctx context.Context, in *StateRet
 // for forward compatibility
 type LedgerPrivilegedServer interface {
 	RegisterCert(context.Context, *CertificateRegistrationRequest) (*emptypb.Empty, error)
+	RegisterSecret(context.Context, *SecretRegistrationRequest) (*emptypb.Empty, error)
 	RegisterFunction(context.Context, *FunctionRegistrationRequest) (*emptypb.Empty, error)
 	RetrieveState(context.Context, *StateRetrievalRequest) (*StateRetrievalResponse, error)
 	mustEmbedUnimplementedLedgerPrivilegedServer()
@@ -342,6 +353,9 @@ type UnimplementedLedgerPrivilegedServer struct {
 func (UnimplementedLedgerPrivilegedServer) RegisterCert(context.Context, *CertificateRegistrationRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RegisterCert not implemented")
 }
+func (UnimplementedLedgerPrivilegedServer) RegisterSecret(context.Context, *SecretRegistrationRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterSecret not implemented")
+}
 func (UnimplementedLedgerPrivilegedServer) RegisterFunction(context.Context, *FunctionRegistrationRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RegisterFunction not implemented")
 }
@@ -379,6 +393,24 @@ func _LedgerPrivileged_RegisterCert_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _LedgerPrivileged_RegisterSecret_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SecretRegistrationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerPrivilegedServer).RegisterSecret(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpc.LedgerPrivileged/RegisterSecret",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerPrivilegedServer).RegisterSecret(ctx, req.(*SecretRegistrationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _LedgerPrivileged_RegisterFunction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(FunctionRegistrationRequest)
 	if err := dec(in); err != nil {
@@ -426,6 +458,10 @@ var LedgerPrivileged_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RegisterCert",
 			Handler:    _LedgerPrivileged_RegisterCert_Handler,
 		},
+		{
+			MethodName: "RegisterSecret",
+			Handler:    _LedgerPrivileged_RegisterSecret_Handler,
+		},
 		{
 			MethodName: "RegisterFunction",
 			Handler:    _LedgerPrivileged_RegisterFunction_Handler,
@@ -674,6 +710,7 @@ var Auditor_ServiceDesc = grpc.ServiceDesc{
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type AuditorPrivilegedClient interface {
 	RegisterCert(ctx context.Context, in *CertificateRegistrationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	RegisterSecret(ctx context.Context, in *SecretRegistrationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 }
 
 type auditorPrivilegedClient struct {
@@ -693,11 +730,21 @@ func (c *auditorPrivilegedClient) RegisterCert(ctx context.Context, in *Certific
 	return out, nil
 }
 
+func (c *auditorPrivilegedClient) RegisterSecret(ctx context.Context, in *SecretRegistrationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/rpc.AuditorPrivileged/RegisterSecret", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AuditorPrivilegedServer is the server API for AuditorPrivileged service.
 // All implementations must embed UnimplementedAuditorPrivilegedServer
 // for forward compatibility
 type AuditorPrivilegedServer interface {
 	RegisterCert(context.Context, *CertificateRegistrationRequest) (*emptypb.Empty, error)
+	RegisterSecret(context.Context, *SecretRegistrationRequest) (*emptypb.Empty, error)
 	mustEmbedUnimplementedAuditorPrivilegedServer()
 }
 
@@ -708,6 +755,9 @@ type UnimplementedAuditorPrivilegedServer struct {
 func (UnimplementedAuditorPrivilegedServer) RegisterCert(context.Context, *CertificateRegistrationRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RegisterCert not implemented")
 }
+func (UnimplementedAuditorPrivilegedServer) RegisterSecret(context.Context, *SecretRegistrationRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterSecret not implemented")
+}
 func (UnimplementedAuditorPrivilegedServer) mustEmbedUnimplementedAuditorPrivilegedServer() {}
 
 // UnsafeAuditorPrivilegedServer may be embedded to opt out of forward compatibility for this service.
@@ -739,6 +789,24 @@ func _AuditorPrivileged_RegisterCert_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AuditorPrivileged_RegisterSecret_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SecretRegistrationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuditorPrivilegedServer).RegisterSecret(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpc.AuditorPrivileged/RegisterSecret",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuditorPrivilegedServer).RegisterSecret(ctx, req.(*SecretRegistrationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // AuditorPrivileged_ServiceDesc is the grpc.ServiceDesc for AuditorPrivileged service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -750,6 +818,10 @@ var AuditorPrivileged_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RegisterCert",
 			Handler:    _AuditorPrivileged_RegisterCert_Handler,
 		},
+		{
+			MethodName: "RegisterSecret",
+			Handler:    _AuditorPrivileged_RegisterSecret_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "scalar.proto",
@@ -0,0 +1,167 @@
+package scalardltest
+
+import (
+	"bytes"
+	"context"
+	"time"
+
+	clientError "github.com/scalar-labs/scalardl-go-client-sdk/v3/client/error"
+	"github.com/scalar-labs/scalardl-go-client-sdk/v3/ledger/statuscode"
+	"github.com/scalar-labs/scalardl-go-client-sdk/v3/rpc"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// orderingTimeout is how long Auditor waits for the preceding executions of the assets to be validated.
+const orderingTimeout = 5 * time.Second
+
+// auditorServer implements rpc.AuditorServer on the node of Auditor.
+type auditorServer struct {
+	rpc.UnimplementedAuditorServer
+	server *Server
+	node   *node
+}
+
+func (a auditorServer) RegisterContract(_ context.Context, request *rpc.ContractRegistrationRequest) (*emptypb.Empty, error) {
+	return &emptypb.Empty{}, a.node.registerContract(request)
+}
+
+func (a auditorServer) ListContracts(_ context.Context, request *rpc.ContractsListingRequest) (*rpc.ContractsListingResponse, error) {
+	contracts, err := a.node.listContracts(request)
+	if err != nil {
+		return nil, err
+	}
+
+	return &rpc.ContractsListingResponse{Json: contracts}, nil
+}
+
+// OrderExecution signs the request with the key of Auditor so that Ledger accepts it.
+func (a auditorServer) OrderExecution(_ context.Context, request *rpc.ContractExecutionRequest) (*rpc.ExecutionOrderingResponse, error) {
+	a.node.mutex.Lock()
+	defer a.node.mutex.Unlock()
+
+	if err := a.node.verify(request.GetCertHolderId(), request.GetCertVersion(), request.VerifyWith); err != nil {
+		return nil, err
+	}
+
+	if _, ok := a.node.contracts[contractKey{certHolderID: request.GetCertHolderId(), id: request.GetContractId()}]; !ok {
+		return nil, clientError.NewClientError(statuscode.ContractNotFound, "the contract is not found in Auditor")
+	}
+
+	var ordered = proto.Clone(request).(*rpc.ContractExecutionRequest)
+	if err := ordered.SignAsAuditorWith(a.node.signer); err != nil {
+		return nil, clientError.NewClientError(statuscode.InvalidSignature, err.Error())
+	}
+
+	return &rpc.ExecutionOrderingResponse{Signature: ordered.GetAuditorSignature()}, nil
+}
+
+// ValidateExecution executes the contract again on the states of Auditor,
+// and commits it if the proofs are the same as the ones from Ledger.
+// Executions are validated in the order Ledger committed them.
+func (a auditorServer) ValidateExecution(ctx context.Context, request *rpc.ExecutionValidationRequest) (*rpc.ContractExecutionResponse, error) {
+	var executionRequest = request.GetRequest()
+
+	if err := a.waitForPreceding(ctx, request.GetProofs()); err != nil {
+		return nil, err
+	}
+	defer a.node.mutex.Unlock()
+
+	if err := a.node.verify(
+		executionRequest.GetCertHolderId(),
+		executionRequest.GetCertVersion(),
+		executionRequest.VerifyWith,
+	); err != nil {
+		return nil, err
+	}
+
+	if !executionRequest.VerifyAuditorSignatureWith(a.server.auditorVerifier) {
+		return nil, clientError.NewClientError(statuscode.InvalidSignature, "the request is not ordered by Auditor")
+	}
+
+	e, err := a.node.execute(executionRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	if !sameProofs(e.proofs, request.GetProofs()) {
+		return nil, clientError.NewClientError(statuscode.InconsistentStates, "the proofs from Ledger don't match the execution in Auditor")
+	}
+
+	a.node.commit(e)
+
+	return &rpc.ContractExecutionResponse{Result: e.result, Proofs: e.proofs}, nil
+}
+
+// waitForPreceding locks the node once the assets in it are just before the ages of the proofs.
+// It fails with InconsistentStates if they are already ahead, or don't catch up in time.
+func (a auditorServer) waitForPreceding(ctx context.Context, proofs []*rpc.AssetProof) error {
+	var deadline = time.Now().Add(orderingTimeout)
+
+	for {
+		a.node.mutex.Lock()
+
+		var ready = true
+
+		for _, p := range proofs {
+			switch ages := len(a.node.assets[p.GetAssetId()]); {
+			case ages > int(p.GetAge()):
+				a.node.mutex.Unlock()
+				return clientError.NewClientError(statuscode.InconsistentStates, "the proofs from Ledger are behind the states of Auditor")
+			case ages < int(p.GetAge()):
+				ready = false
+			}
+		}
+
+		if ready {
+			return nil
+		}
+
+		a.node.mutex.Unlock()
+
+		if ctx.Err() != nil || time.Now().After(deadline) {
+			return clientError.NewClientError(statuscode.InconsistentStates, "the proofs from Ledger are ahead of the states of Auditor")
+		}
+
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func (a auditorServer) ValidateLedger(_ context.Context, request *rpc.LedgerValidationRequest) (*rpc.LedgerValidationResponse, error) {
+	return a.node.validate(request)
+}
+
+// auditorPrivilegedServer implements rpc.AuditorPrivilegedServer on the node of Auditor.
+type auditorPrivilegedServer struct {
+	rpc.UnimplementedAuditorPrivilegedServer
+	node *node
+}
+
+func (a auditorPrivilegedServer) RegisterCert(_ context.Context, request *rpc.CertificateRegistrationRequest) (*emptypb.Empty, error) {
+	return &emptypb.Empty{}, a.node.registerCert(request)
+}
+
+func (a auditorPrivilegedServer) RegisterSecret(_ context.Context, request *rpc.SecretRegistrationRequest) (*emptypb.Empty, error) {
+	return &emptypb.Empty{}, a.node.registerSecret(request)
+}
+
+// sameProofs checks if two executions produce the same ages and hashes of the same assets.
+func sameProofs(proofs []*rpc.AssetProof, another []*rpc.AssetProof) bool {
+	if len(proofs) != len(another) {
+		return false
+	}
+
+	var ages = make(map[string]*rpc.AssetProof)
+	for _, p := range another {
+		ages[p.GetAssetId()] = p
+	}
+
+	for _, p := range proofs {
+		q, ok := ages[p.GetAssetId()]
+		if !ok || p.GetAge() != q.GetAge() || !bytes.Equal(p.GetHash(), q.GetHash()) {
+			return false
+		}
+	}
+
+	return true
+}
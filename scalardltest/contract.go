@@ -0,0 +1,112 @@
+package scalardltest
+
+import (
+	"sort"
+
+	"github.com/scalar-labs/scalardl-go-client-sdk/v3/json"
+)
+
+// Contract defines the behavior of a contract in Go.
+// It replaces the Java contract whose binary name it is defined for with Server.DefineContract.
+// The argument includes the nonce, and the properties are the ones registered with the contract, or nil.
+// Returning ClientError fails the execution with its status code, and any other error with ContractContextualError.
+type Contract func(ledger Ledger, argument json.Object, properties json.Object) (result json.Object, err error)
+
+// Ledger defines the operations on the assets available to a Contract.
+type Ledger interface {
+	// Get returns the latest age of the asset, or false if the asset doesn't exist.
+	// The assets put in the same execution are not visible until it is committed.
+	Get(assetID string) (asset Asset, ok bool)
+
+	// Scan returns all the ages of the asset from the oldest one.
+	Scan(assetID string) []Asset
+
+	// Put writes the data as the next age of the asset, which is committed after the contract returns.
+	// The last data put to an asset in an execution wins.
+	Put(assetID string, data json.Object)
+}
+
+// Asset defines an age of an asset.
+type Asset struct {
+	ID   string
+	Age  int
+	Data json.Object
+}
+
+// transaction implements Ledger on the states of a node, and records what the contract reads and writes.
+type transaction struct {
+	node   *node
+	reads  map[string]int
+	writes map[string]json.Object
+}
+
+func newTransaction(n *node) *transaction {
+	return &transaction{
+		node:   n,
+		reads:  make(map[string]int),
+		writes: make(map[string]json.Object),
+	}
+}
+
+func (t *transaction) Get(assetID string) (asset Asset, ok bool) {
+	var records = t.node.assets[assetID]
+	if len(records) == 0 {
+		return
+	}
+
+	var latest = records[len(records)-1]
+	t.reads[assetID] = latest.age
+
+	return Asset{ID: assetID, Age: latest.age, Data: clone(latest.data)}, true
+}
+
+func (t *transaction) Scan(assetID string) (assets []Asset) {
+	var records = t.node.assets[assetID]
+
+	for _, r := range records {
+		assets = append(assets, Asset{ID: assetID, Age: r.age, Data: clone(r.data)})
+	}
+
+	if len(records) > 0 {
+		t.reads[assetID] = records[len(records)-1].age
+	}
+
+	return
+}
+
+func (t *transaction) Put(assetID string, data json.Object) {
+	t.writes[assetID] = clone(data)
+}
+
+// input returns the ages of the assets read by the contract as the input of the proofs.
+func (t *transaction) input() string {
+	var input = json.Object{}
+
+	for id, age := range t.reads {
+		input[id] = json.Object{"age": age}
+	}
+
+	return input.String()
+}
+
+// written returns the IDs of the assets written by the contract in order.
+func (t *transaction) written() (ids []string) {
+	for id := range t.writes {
+		ids = append(ids, id)
+	}
+
+	sort.Strings(ids)
+
+	return
+}
+
+// clone copies a JSON object through its JSON text, so that the states are not shared with contracts.
+func clone(o json.Object) json.Object {
+	if o == nil {
+		return nil
+	}
+
+	cloned, _ := json.FromJSON(o.String())
+
+	return cloned
+}
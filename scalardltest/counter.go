@@ -0,0 +1,93 @@
+package scalardltest
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/scalar-labs/scalardl-go-client-sdk/v3/client/config"
+	"github.com/scalar-labs/scalardl-go-client-sdk/v3/client/service"
+	"github.com/scalar-labs/scalardl-go-client-sdk/v3/json"
+	"google.golang.org/grpc"
+)
+
+const (
+	// CounterName is the binary name of Counter, which every Server defines.
+	CounterName = "com.example.Counter"
+
+	// CounterID is the contract ID that RegisterCounter registers Counter as.
+	CounterID = "counter"
+)
+
+// Counter is a contract for the tests, which adds the amount in the argument to the balance of the asset
+// and returns the new balance, e.g. {"balance": 10} for {"asset_id": "a", "amount": 10}.
+// It fails for a negative amount.
+func Counter(ledger Ledger, argument json.Object, _ json.Object) (json.Object, error) {
+	var (
+		id, _     = argument["asset_id"].(string)
+		amount, _ = argument["amount"].(float64)
+		balance   float64
+	)
+
+	if amount < 0 {
+		return nil, fmt.Errorf("amount must not be negative")
+	}
+
+	if asset, ok := ledger.Get(id); ok {
+		balance, _ = asset.Data["balance"].(float64)
+	}
+
+	ledger.Put(id, json.Object{"balance": balance + amount})
+
+	return json.Object{"balance": balance + amount}, nil
+}
+
+// Connect creates ClientService connected to the server with the client config, e.g. one from ClientConfig,
+// and the additional dial options, e.g. interceptors.
+func (s *Server) Connect(c config.ClientConfig, dialOptions ...grpc.DialOption) (service.ClientService, error) {
+	return service.NewClientServiceWithOptions(c, service.ClientServiceOptions{
+		DialOptions: append(s.DialOptions(), dialOptions...),
+	})
+}
+
+// NewClientService creates ClientService of the certificate holder with the client config from NewClientConfig.
+func (s *Server) NewClientService(certHolderID string, dialOptions ...grpc.DialOption) (service.ClientService, error) {
+	c, err := s.NewClientConfig(certHolderID)
+	if err != nil {
+		return service.ClientService{}, err
+	}
+
+	return s.Connect(c, dialOptions...)
+}
+
+// RegisterCounter registers the certificate of the client and Counter as CounterID.
+func RegisterCounter(client service.Client) error {
+	if err := client.RegisterCertificate(); err != nil {
+		return err
+	}
+
+	return client.RegisterContract(CounterID, CounterName, []byte{0xCA, 0xFE}, nil)
+}
+
+// NewTestClient starts Server with the options, and connects ClientService of alice with the additional dial options,
+// whose certificate and Counter are registered by RegisterCounter. Both are closed when the test finishes.
+func NewTestClient(t testing.TB, options Options, dialOptions ...grpc.DialOption) (*Server, service.ClientService) {
+	t.Helper()
+
+	server, err := NewServer(options)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(server.Close)
+
+	s, err := server.NewClientService("alice", dialOptions...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(s.Close)
+
+	if err = RegisterCounter(s); err != nil {
+		t.Fatalf("should register the certificate and Counter: %v", err)
+	}
+
+	return server, s
+}
@@ -0,0 +1,207 @@
+package scalardltest
+
+import (
+	"context"
+	"math/rand"
+	"sync"
+	"time"
+
+	clientError "github.com/scalar-labs/scalardl-go-client-sdk/v3/client/error"
+	"github.com/scalar-labs/scalardl-go-client-sdk/v3/ledger/statuscode"
+	"github.com/scalar-labs/scalardl-go-client-sdk/v3/rpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// Fault defines what happens to the calls of a method from ClientService.
+// The delay comes first, and then at most one of dropping the request, returning the status code,
+// dropping the response and corrupting the response, in this order, is applied.
+type Fault struct {
+	// Method is the full gRPC method name, e.g. "/rpc.Auditor/ValidateExecution". An empty one matches all the methods.
+	Method string
+
+	// Delay delays the call. A call whose context is done in the delay fails with DeadlineExceeded.
+	Delay time.Duration
+
+	// DropRequest fails the call with Code without sending it.
+	DropRequest bool
+
+	// StatusCode fails the call without sending it, as if the server returned the status code and Message in the trailer.
+	StatusCode statuscode.StatusCode
+	Message    string
+
+	// DropResponse sends the call, and fails it with Code even if the server succeeds, e.g. to lose the response of a committed execution.
+	DropResponse bool
+
+	// Code is the gRPC code of the dropped calls. It is Unavailable by default.
+	Code codes.Code
+
+	// Corrupt modifies the response received from the server, e.g. CorruptHashes.
+	Corrupt func(response proto.Message)
+
+	// Times limits the fault to the number of calls. It is applied to all the calls if it is not positive.
+	Times int
+
+	// Probability applies the fault to the calls at random with the probability. It is applied to all the calls if it is 0.
+	Probability float64
+}
+
+// FaultInjector applies the faults to the calls of ClientService to Ledger and Auditor.
+// The first fault matching a call, which is not used up, is applied to it.
+type FaultInjector struct {
+	mutex   sync.Mutex
+	faults  []*injectedFault
+	applied map[string]int
+	random  *rand.Rand
+}
+
+// injectedFault is a fault with the number of the calls it is applied to.
+type injectedFault struct {
+	Fault
+	applied int
+}
+
+// NewFaultInjector creates FaultInjector without any fault.
+func NewFaultInjector() *FaultInjector {
+	return &FaultInjector{
+		applied: make(map[string]int),
+		random:  rand.New(rand.NewSource(time.Now().UnixNano())),
+	}
+}
+
+// Add adds the faults.
+func (f *FaultInjector) Add(faults ...Fault) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	for _, fault := range faults {
+		f.faults = append(f.faults, &injectedFault{Fault: fault})
+	}
+}
+
+// Clear removes all the faults.
+func (f *FaultInjector) Clear() {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	f.faults = nil
+}
+
+// Applied returns the number of the calls of the method that the faults are applied to.
+func (f *FaultInjector) Applied(method string) int {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	return f.applied[method]
+}
+
+// DialOptions returns the options for ClientService to inject the faults.
+// They can be used along with the ones of Server to test against the in-process servers.
+func (f *FaultInjector) DialOptions() []grpc.DialOption {
+	return []grpc.DialOption{grpc.WithChainUnaryInterceptor(f.intercept)}
+}
+
+// next returns the fault applied to the call of the method.
+func (f *FaultInjector) next(method string) (fault Fault, ok bool) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	for _, injected := range f.faults {
+		if injected.Method != "" && injected.Method != method {
+			continue
+		}
+
+		if injected.Times > 0 && injected.applied >= injected.Times {
+			continue
+		}
+
+		if injected.Probability > 0 && f.random.Float64() >= injected.Probability {
+			continue
+		}
+
+		injected.applied++
+		f.applied[method]++
+
+		return injected.Fault, true
+	}
+
+	return
+}
+
+func (f *FaultInjector) intercept(
+	ctx context.Context,
+	method string,
+	request interface{},
+	reply interface{},
+	cc *grpc.ClientConn,
+	invoker grpc.UnaryInvoker,
+	opts ...grpc.CallOption,
+) error {
+	fault, ok := f.next(method)
+	if !ok {
+		return invoker(ctx, method, request, reply, cc, opts...)
+	}
+
+	if fault.Delay > 0 {
+		var timer = time.NewTimer(fault.Delay)
+		defer timer.Stop()
+
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			return status.FromContextError(ctx.Err()).Err()
+		}
+	}
+
+	var code = fault.Code
+	if code == codes.OK {
+		code = codes.Unavailable
+	}
+
+	switch {
+	case fault.DropRequest:
+		return status.Errorf(code, "the request of %s is dropped", method)
+	case fault.StatusCode != 0:
+		var e = clientError.NewClientError(fault.StatusCode, fault.Message)
+		setTrailer(opts, statusTrailer(e))
+
+		return status.Error(toCode(e.StatusCode()), e.Error())
+	}
+
+	if err := invoker(ctx, method, request, reply, cc, opts...); err != nil {
+		return err
+	}
+
+	if fault.DropResponse {
+		return status.Errorf(code, "the response of %s is dropped", method)
+	}
+
+	if message, ok := reply.(proto.Message); ok && fault.Corrupt != nil {
+		fault.Corrupt(message)
+	}
+
+	return nil
+}
+
+// CorruptHashes flips the hashes of the proofs in the responses of the contract execution,
+// the ledger validation and the asset proof retrieval, e.g. to make ValidateExecution return mismatched proofs.
+func CorruptHashes(response proto.Message) {
+	var proofs []*rpc.AssetProof
+
+	switch r := response.(type) {
+	case *rpc.ContractExecutionResponse:
+		proofs = r.GetProofs()
+	case *rpc.LedgerValidationResponse:
+		proofs = append(proofs, r.GetProof())
+	case *rpc.AssetProofRetrievalResponse:
+		proofs = append(proofs, r.GetProof())
+	}
+
+	for _, p := range proofs {
+		if p != nil && len(p.Hash) > 0 {
+			p.Hash[0] ^= 0xFF
+		}
+	}
+}
@@ -0,0 +1,102 @@
+package scalardltest
+
+import (
+	"context"
+
+	clientError "github.com/scalar-labs/scalardl-go-client-sdk/v3/client/error"
+	"github.com/scalar-labs/scalardl-go-client-sdk/v3/ledger/statuscode"
+	"github.com/scalar-labs/scalardl-go-client-sdk/v3/rpc"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// ledgerServer implements rpc.LedgerServer on the node of Ledger.
+type ledgerServer struct {
+	rpc.UnimplementedLedgerServer
+	server *Server
+	node   *node
+}
+
+func (l ledgerServer) RegisterContract(_ context.Context, request *rpc.ContractRegistrationRequest) (*emptypb.Empty, error) {
+	return &emptypb.Empty{}, l.node.registerContract(request)
+}
+
+func (l ledgerServer) ListContracts(_ context.Context, request *rpc.ContractsListingRequest) (*rpc.ContractsListingResponse, error) {
+	contracts, err := l.node.listContracts(request)
+	if err != nil {
+		return nil, err
+	}
+
+	return &rpc.ContractsListingResponse{Json: contracts}, nil
+}
+
+// ExecuteContract executes and commits the contract unless it is a pre-execution.
+// With Auditor, the request must be ordered by Auditor.
+func (l ledgerServer) ExecuteContract(_ context.Context, request *rpc.ContractExecutionRequest) (*rpc.ContractExecutionResponse, error) {
+	l.node.mutex.Lock()
+	defer l.node.mutex.Unlock()
+
+	if err := l.node.verify(request.GetCertHolderId(), request.GetCertVersion(), request.VerifyWith); err != nil {
+		return nil, err
+	}
+
+	if l.server.auditor != nil && !request.GetPreExecution() {
+		if !request.VerifyAuditorSignatureWith(l.server.auditorVerifier) {
+			return nil, clientError.NewClientError(statuscode.InvalidSignature, "the request is not ordered by Auditor")
+		}
+	}
+
+	e, err := l.node.execute(request)
+	if err != nil {
+		return nil, err
+	}
+
+	if !request.GetPreExecution() {
+		l.node.commit(e)
+	}
+
+	return &rpc.ContractExecutionResponse{Result: e.result, Proofs: e.proofs}, nil
+}
+
+func (l ledgerServer) ValidateLedger(_ context.Context, request *rpc.LedgerValidationRequest) (*rpc.LedgerValidationResponse, error) {
+	return l.node.validate(request)
+}
+
+func (l ledgerServer) RetrieveAssetProof(_ context.Context, request *rpc.AssetProofRetrievalRequest) (*rpc.AssetProofRetrievalResponse, error) {
+	proof, err := l.node.retrieveProof(request)
+	if err != nil {
+		return nil, err
+	}
+
+	return &rpc.AssetProofRetrievalResponse{Proof: proof}, nil
+}
+
+func (l ledgerServer) AbortExecution(_ context.Context, request *rpc.ExecutionAbortRequest) (*rpc.ExecutionAbortResponse, error) {
+	state, err := l.node.abort(request)
+	if err != nil {
+		return nil, err
+	}
+
+	return &rpc.ExecutionAbortResponse{State: state}, nil
+}
+
+// ledgerPrivilegedServer implements rpc.LedgerPrivilegedServer on the node of Ledger.
+type ledgerPrivilegedServer struct {
+	rpc.UnimplementedLedgerPrivilegedServer
+	node *node
+}
+
+func (l ledgerPrivilegedServer) RegisterCert(_ context.Context, request *rpc.CertificateRegistrationRequest) (*emptypb.Empty, error) {
+	return &emptypb.Empty{}, l.node.registerCert(request)
+}
+
+func (l ledgerPrivilegedServer) RegisterSecret(_ context.Context, request *rpc.SecretRegistrationRequest) (*emptypb.Empty, error) {
+	return &emptypb.Empty{}, l.node.registerSecret(request)
+}
+
+func (l ledgerPrivilegedServer) RegisterFunction(_ context.Context, request *rpc.FunctionRegistrationRequest) (*emptypb.Empty, error) {
+	return &emptypb.Empty{}, l.node.registerFunction(request)
+}
+
+func (l ledgerPrivilegedServer) RetrieveState(_ context.Context, request *rpc.StateRetrievalRequest) (*rpc.StateRetrievalResponse, error) {
+	return &rpc.StateRetrievalResponse{State: l.node.state(request.GetTransactionId())}, nil
+}
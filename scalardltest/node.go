@@ -0,0 +1,459 @@
+package scalardltest
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"fmt"
+	"sync"
+	"time"
+
+	clientError "github.com/scalar-labs/scalardl-go-client-sdk/v3/client/error"
+	"github.com/scalar-labs/scalardl-go-client-sdk/v3/crypto"
+	"github.com/scalar-labs/scalardl-go-client-sdk/v3/json"
+	"github.com/scalar-labs/scalardl-go-client-sdk/v3/ledger/asset"
+	"github.com/scalar-labs/scalardl-go-client-sdk/v3/ledger/statuscode"
+	"github.com/scalar-labs/scalardl-go-client-sdk/v3/rpc"
+)
+
+// holderKey identifies a certificate or a secret key version of a holder.
+type holderKey struct {
+	id      string
+	version uint32
+}
+
+// contractKey identifies a contract registered by a certificate holder.
+type contractKey struct {
+	certHolderID string
+	id           string
+}
+
+// registeredContract is a contract registered to a node.
+type registeredContract struct {
+	binaryName   string
+	properties   string
+	signature    []byte
+	registeredAt time.Time
+}
+
+// record is an age of an asset in the hash chain.
+type record struct {
+	age        int
+	nonce      string
+	input      string
+	data       json.Object
+	contractID string
+	argument   string
+	hash       []byte
+	prevHash   []byte
+}
+
+// execution is the outcome of a contract execution before it is committed.
+type execution struct {
+	nonce   string
+	result  string
+	records map[string]record
+	proofs  []*rpc.AssetProof
+}
+
+// node holds the states of Ledger or Auditor, and signs the proofs with its own key.
+// All the operations on a node are serialized by its mutex.
+type node struct {
+	server *Server
+	signer crypto.Signer
+
+	mutex     sync.Mutex
+	verifiers map[holderKey]crypto.Verifier
+	contracts map[contractKey]registeredContract
+	functions map[string]string
+	assets    map[string][]record
+	nonces    map[string]rpc.TransactionState
+}
+
+func newNode(server *Server, signer crypto.Signer) *node {
+	return &node{
+		server:    server,
+		signer:    signer,
+		verifiers: make(map[holderKey]crypto.Verifier),
+		contracts: make(map[contractKey]registeredContract),
+		functions: make(map[string]string),
+		assets:    make(map[string][]record),
+		nonces:    make(map[string]rpc.TransactionState),
+	}
+}
+
+func (n *node) registerCert(request *rpc.CertificateRegistrationRequest) error {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+
+	if request.GetCertHolderId() == "" {
+		return clientError.NewClientError(statuscode.InvalidRequest, "cert_holder_id cannot be empty")
+	}
+
+	var key = holderKey{id: request.GetCertHolderId(), version: request.GetCertVersion()}
+	if _, ok := n.verifiers[key]; ok {
+		return clientError.NewClientError(statuscode.CertificateAlreadyRegistered, "the certificate is already registered")
+	}
+
+	verifier, err := crypto.NewVerifier([]byte(request.GetCertPem()))
+	if err != nil {
+		return clientError.NewClientError(statuscode.UnloadableKey, err.Error())
+	}
+
+	n.verifiers[key] = verifier
+
+	return nil
+}
+
+func (n *node) registerSecret(request *rpc.SecretRegistrationRequest) error {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+
+	if request.GetEntityId() == "" {
+		return clientError.NewClientError(statuscode.InvalidRequest, "entity_id cannot be empty")
+	}
+
+	var key = holderKey{id: request.GetEntityId(), version: request.GetKeyVersion()}
+	if _, ok := n.verifiers[key]; ok {
+		return clientError.NewClientError(statuscode.SecretAlreadyRegistered, "the secret key is already registered")
+	}
+
+	verifier, err := crypto.NewHmacSha256Verifier(request.GetSecretKey())
+	if err != nil {
+		return clientError.NewClientError(statuscode.UnloadableKey, err.Error())
+	}
+
+	n.verifiers[key] = verifier
+
+	return nil
+}
+
+// verify checks the signature of a request with the certificate or the secret key of the holder.
+// The caller must hold the mutex.
+func (n *node) verify(certHolderID string, certVersion uint32, verify func(crypto.Verifier) bool) error {
+	verifier, ok := n.verifiers[holderKey{id: certHolderID, version: certVersion}]
+	if !ok {
+		return clientError.NewClientError(
+			statuscode.CertificateNotFound,
+			fmt.Sprintf("the certificate of %s (version %d) is not found", certHolderID, certVersion),
+		)
+	}
+
+	if !verify(verifier) {
+		return clientError.NewClientError(statuscode.InvalidSignature, "the request signature can't be validated")
+	}
+
+	return nil
+}
+
+func (n *node) registerContract(request *rpc.ContractRegistrationRequest) error {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+
+	if err := n.verify(request.GetCertHolderId(), request.GetCertVersion(), request.VerifyWith); err != nil {
+		return err
+	}
+
+	if _, ok := n.server.contract(request.GetContractBinaryName()); !ok {
+		return clientError.NewClientError(
+			statuscode.UnloadableContract,
+			fmt.Sprintf("contract %s is not defined in the test server", request.GetContractBinaryName()),
+		)
+	}
+
+	var key = contractKey{certHolderID: request.GetCertHolderId(), id: request.GetContractId()}
+	if _, ok := n.contracts[key]; ok {
+		return clientError.NewClientError(statuscode.ContractAlreadyRegistered, "the contract is already registered")
+	}
+
+	n.contracts[key] = registeredContract{
+		binaryName:   request.GetContractBinaryName(),
+		properties:   request.GetContractProperties(),
+		signature:    request.GetSignature(),
+		registeredAt: time.Now(),
+	}
+
+	return nil
+}
+
+func (n *node) registerFunction(request *rpc.FunctionRegistrationRequest) error {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+
+	if request.GetFunctionId() == "" {
+		return clientError.NewClientError(statuscode.InvalidRequest, "function_id cannot be empty")
+	}
+
+	n.functions[request.GetFunctionId()] = request.GetFunctionBinaryName()
+
+	return nil
+}
+
+func (n *node) listContracts(request *rpc.ContractsListingRequest) (string, error) {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+
+	if err := n.verify(request.GetCertHolderId(), request.GetCertVersion(), request.VerifyWith); err != nil {
+		return "", err
+	}
+
+	var contracts = json.Object{}
+
+	for key, c := range n.contracts {
+		if key.certHolderID != request.GetCertHolderId() {
+			continue
+		}
+
+		if request.GetContractId() != "" && key.id != request.GetContractId() {
+			continue
+		}
+
+		contracts[key.id] = json.Object{
+			"contract_name":       c.binaryName,
+			"contract_properties": c.properties,
+			"registered_at":       c.registeredAt.UnixNano() / int64(time.Millisecond),
+			"signature":           base64.StdEncoding.EncodeToString(c.signature),
+		}
+	}
+
+	return contracts.String(), nil
+}
+
+// execute runs the contract of the request on the states of the node without committing them.
+// The caller must hold the mutex.
+func (n *node) execute(request *rpc.ContractExecutionRequest) (e execution, err error) {
+	registered, ok := n.contracts[contractKey{certHolderID: request.GetCertHolderId(), id: request.GetContractId()}]
+	if !ok {
+		return e, clientError.NewClientError(
+			statuscode.ContractNotFound,
+			fmt.Sprintf("contract %s is not found", request.GetContractId()),
+		)
+	}
+
+	contract, ok := n.server.contract(registered.binaryName)
+	if !ok {
+		return e, clientError.NewClientError(
+			statuscode.UnloadableContract,
+			fmt.Sprintf("contract %s is not defined in the test server", registered.binaryName),
+		)
+	}
+
+	var argument json.Object
+	if argument, err = json.FromJSON(request.GetContractArgument()); err != nil {
+		return e, clientError.NewClientError(statuscode.InvalidRequest, "the contract argument is not a JSON object")
+	}
+
+	if e.nonce, ok = argument["nonce"].(string); !ok || e.nonce == "" {
+		return e, clientError.NewClientError(statuscode.InvalidRequest, "the contract argument has no nonce")
+	}
+
+	if _, ok = n.nonces[e.nonce]; ok {
+		return e, clientError.NewClientError(statuscode.InvalidNonce, fmt.Sprintf("nonce %s is already used", e.nonce))
+	}
+
+	var properties json.Object
+	if registered.properties != "" {
+		properties, _ = json.FromJSON(registered.properties)
+	}
+
+	var (
+		t      = newTransaction(n)
+		result json.Object
+	)
+
+	if result, err = contract(t, argument, properties); err != nil {
+		if _, ok = err.(clientError.ClientError); !ok {
+			err = clientError.NewClientError(statuscode.ContractContextualError, err.Error())
+		}
+
+		return
+	}
+
+	if result != nil {
+		e.result = result.String()
+	}
+
+	e.records = make(map[string]record)
+
+	for _, id := range t.written() {
+		var r = record{
+			age:        0,
+			nonce:      e.nonce,
+			input:      t.input(),
+			data:       t.writes[id],
+			contractID: request.GetContractId(),
+			argument:   request.GetContractArgument(),
+		}
+
+		if records := n.assets[id]; len(records) > 0 {
+			r.age = records[len(records)-1].age + 1
+			r.prevHash = records[len(records)-1].hash
+		}
+
+		r.hash = r.computeHash(id)
+		e.records[id] = r
+
+		var proof *rpc.AssetProof
+		if proof, err = n.proof(id, r); err != nil {
+			return
+		}
+
+		e.proofs = append(e.proofs, proof)
+	}
+
+	return
+}
+
+// commit applies the execution to the states of the node.
+// The caller must hold the mutex.
+func (n *node) commit(e execution) {
+	for id, r := range e.records {
+		n.assets[id] = append(n.assets[id], r)
+	}
+
+	n.nonces[e.nonce] = rpc.TransactionState_TRANSACTION_STATE_COMMITTED
+}
+
+// proof creates the proof of an age of an asset signed by the node.
+func (n *node) proof(id string, r record) (proof *rpc.AssetProof, err error) {
+	proof = &rpc.AssetProof{
+		AssetId:  id,
+		Age:      uint32(r.age),
+		Nonce:    r.nonce,
+		Input:    r.input,
+		Hash:     r.hash,
+		PrevHash: r.prevHash,
+	}
+
+	if proof.Signature, err = n.signer.Sign(asset.FromGRPC(proof).Serialize()); err != nil {
+		err = clientError.NewClientError(statuscode.InvalidSignature, err.Error())
+	}
+
+	return
+}
+
+// validate checks the hash chain of the asset between the ages,
+// and returns the proof of the last age checked.
+func (n *node) validate(request *rpc.LedgerValidationRequest) (response *rpc.LedgerValidationResponse, err error) {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+
+	if err = n.verify(request.GetCertHolderId(), request.GetCertVersion(), request.VerifyWith); err != nil {
+		return
+	}
+
+	var records = n.assets[request.GetAssetId()]
+	if len(records) == 0 {
+		return nil, clientError.NewClientError(
+			statuscode.AssetNotFound,
+			fmt.Sprintf("asset %s is not found", request.GetAssetId()),
+		)
+	}
+
+	var (
+		code = statuscode.StatusCode(statuscode.OK)
+		last record
+	)
+
+	for i, r := range records {
+		if r.age < int(request.GetStartAge()) || r.age > int(request.GetEndAge()) {
+			continue
+		}
+
+		last = r
+
+		if code != statuscode.OK {
+			continue
+		}
+
+		if i > 0 && string(r.prevHash) != string(records[i-1].hash) {
+			code = statuscode.InvalidPrevHash
+		} else if string(r.hash) != string(r.computeHash(request.GetAssetId())) {
+			code = statuscode.InvalidHash
+		}
+	}
+
+	response = &rpc.LedgerValidationResponse{StatusCode: uint32(code)}
+
+	if last.hash != nil {
+		response.Proof, err = n.proof(request.GetAssetId(), last)
+	}
+
+	return
+}
+
+// retrieveProof returns the proof of the age of the asset, or the latest one for a negative age.
+func (n *node) retrieveProof(request *rpc.AssetProofRetrievalRequest) (proof *rpc.AssetProof, err error) {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+
+	if err = n.verify(request.GetCertHolderId(), request.GetCertVersion(), request.VerifyWith); err != nil {
+		return
+	}
+
+	var (
+		records = n.assets[request.GetAssetId()]
+		age     = int(request.GetAge())
+	)
+
+	if age < 0 {
+		age = len(records) - 1
+	}
+
+	if age < 0 || age >= len(records) {
+		return nil, clientError.NewClientError(
+			statuscode.AssetNotFound,
+			fmt.Sprintf("asset %s (age %d) is not found", request.GetAssetId(), request.GetAge()),
+		)
+	}
+
+	return n.proof(request.GetAssetId(), records[age])
+}
+
+// abort aborts the execution with the nonce unless it is committed, and returns its state.
+func (n *node) abort(request *rpc.ExecutionAbortRequest) (state rpc.TransactionState, err error) {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+
+	if err = n.verify(request.GetCertHolderId(), request.GetCertVersion(), request.VerifyWith); err != nil {
+		return
+	}
+
+	if state, ok := n.nonces[request.GetNonce()]; ok {
+		return state, nil
+	}
+
+	n.nonces[request.GetNonce()] = rpc.TransactionState_TRANSACTION_STATE_ABORTED
+
+	return rpc.TransactionState_TRANSACTION_STATE_ABORTED, nil
+}
+
+// state returns the state of the execution with the nonce.
+func (n *node) state(nonce string) rpc.TransactionState {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+
+	if state, ok := n.nonces[nonce]; ok {
+		return state
+	}
+
+	return rpc.TransactionState_TRANSACTION_STATE_UNKNOWN
+}
+
+// computeHash hashes an age of the asset together with the hash of the previous age.
+func (r record) computeHash(id string) []byte {
+	var ageBytes = make([]byte, 4)
+	binary.BigEndian.PutUint32(ageBytes, uint32(r.age))
+
+	var h = sha256.New()
+	h.Write([]byte(id))
+	h.Write(ageBytes)
+	h.Write([]byte(r.nonce))
+	h.Write([]byte(r.input))
+	h.Write([]byte(r.data.String()))
+	h.Write([]byte(r.contractID))
+	h.Write([]byte(r.argument))
+	h.Write(r.prevHash)
+
+	return h.Sum(nil)
+}
@@ -0,0 +1,341 @@
+package scalardltest
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"strings"
+	"sync"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// Interaction is a gRPC call recorded by Recorder: the request, and the response or the error with the trailer.
+// The requests and the responses are in the JSON mapping of protobuf with the field names in the proto files,
+// and the values of the binary trailers, such as rpc.status-bin, are base64-encoded.
+type Interaction struct {
+	Method   string              `json:"method"`
+	Request  json.RawMessage     `json:"request"`
+	Response json.RawMessage     `json:"response,omitempty"`
+	Code     codes.Code          `json:"code"`
+	Message  string              `json:"message,omitempty"`
+	Trailer  map[string][]string `json:"trailer,omitempty"`
+}
+
+// golden is the file format of the recorded interactions.
+type golden struct {
+	Interactions []Interaction `json:"interactions"`
+}
+
+// Recorder records the gRPC calls of ClientService to Ledger and Auditor to replay them with Replayer.
+type Recorder struct {
+	mutex        sync.Mutex
+	interactions []Interaction
+}
+
+// NewRecorder creates a Recorder without any interaction.
+func NewRecorder() *Recorder {
+	return &Recorder{}
+}
+
+// DialOptions returns the options for ClientService to record the calls:
+//
+//	service.NewClientServiceWithOptions(c, service.ClientServiceOptions{DialOptions: recorder.DialOptions()})
+//
+// They can be used along with the ones of Server to record the calls to it.
+func (r *Recorder) DialOptions() []grpc.DialOption {
+	return []grpc.DialOption{grpc.WithChainUnaryInterceptor(r.intercept)}
+}
+
+// Interactions returns the recorded interactions in order.
+func (r *Recorder) Interactions() []Interaction {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	return append([]Interaction(nil), r.interactions...)
+}
+
+// Save writes the recorded interactions to the golden file, which LoadReplayer reads.
+func (r *Recorder) Save(path string) error {
+	encoded, err := json.MarshalIndent(golden{Interactions: r.Interactions()}, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(path, append(encoded, '\n'), 0644)
+}
+
+func (r *Recorder) intercept(
+	ctx context.Context,
+	method string,
+	request interface{},
+	reply interface{},
+	cc *grpc.ClientConn,
+	invoker grpc.UnaryInvoker,
+	opts ...grpc.CallOption,
+) error {
+	var trailer metadata.MD
+
+	err := invoker(ctx, method, request, reply, cc, append(opts, grpc.Trailer(&trailer))...)
+
+	var (
+		interaction = Interaction{Method: method, Trailer: encodeTrailer(trailer)}
+		e           error
+	)
+
+	if interaction.Request, e = marshal(request); e != nil {
+		return e
+	}
+
+	if err == nil {
+		interaction.Response, _ = marshal(reply)
+	} else {
+		s := status.Convert(err)
+		interaction.Code = s.Code()
+		interaction.Message = s.Message()
+	}
+
+	r.mutex.Lock()
+	r.interactions = append(r.interactions, interaction)
+	r.mutex.Unlock()
+
+	return err
+}
+
+// Replayer returns the recorded interactions to ClientService instead of calling Ledger and Auditor.
+// A call is answered by the first unused interaction of the same method and request,
+// where the nonces and the signatures, which differ in every run, are ignored.
+type Replayer struct {
+	mutex   sync.Mutex
+	pending map[string][]Interaction
+}
+
+// NewReplayer creates a Replayer of the interactions.
+func NewReplayer(interactions []Interaction) (r *Replayer, err error) {
+	r = &Replayer{pending: make(map[string][]Interaction)}
+
+	for _, interaction := range interactions {
+		var key string
+
+		if key, err = matchKey(interaction.Method, interaction.Request); err != nil {
+			return nil, err
+		}
+
+		r.pending[key] = append(r.pending[key], interaction)
+	}
+
+	return
+}
+
+// LoadReplayer creates a Replayer of the interactions in the golden file saved by Recorder.
+func LoadReplayer(path string) (*Replayer, error) {
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var g golden
+	if err = json.Unmarshal(content, &g); err != nil {
+		return nil, fmt.Errorf("%s is not a golden file: %w", path, err)
+	}
+
+	return NewReplayer(g.Interactions)
+}
+
+// DialOptions returns the options for ClientService to replay the interactions.
+// ClientService doesn't connect to any server with them.
+func (r *Replayer) DialOptions() []grpc.DialOption {
+	return []grpc.DialOption{
+		grpc.WithChainUnaryInterceptor(r.intercept),
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) {
+			return nil, fmt.Errorf("the calls are replayed")
+		}),
+	}
+}
+
+// Unused returns the number of the interactions that are not replayed yet.
+func (r *Replayer) Unused() (unused int) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	for _, interactions := range r.pending {
+		unused += len(interactions)
+	}
+
+	return
+}
+
+func (r *Replayer) intercept(
+	_ context.Context,
+	method string,
+	request interface{},
+	reply interface{},
+	_ *grpc.ClientConn,
+	_ grpc.UnaryInvoker,
+	opts ...grpc.CallOption,
+) error {
+	marshaled, err := marshal(request)
+	if err != nil {
+		return err
+	}
+
+	key, err := matchKey(method, marshaled)
+	if err != nil {
+		return err
+	}
+
+	r.mutex.Lock()
+	var interactions = r.pending[key]
+	if len(interactions) > 0 {
+		r.pending[key] = interactions[1:]
+	}
+	r.mutex.Unlock()
+
+	if len(interactions) == 0 {
+		return status.Errorf(codes.FailedPrecondition, "no recorded interaction of %s for %s", method, marshaled)
+	}
+
+	var interaction = interactions[0]
+
+	setTrailer(opts, decodeTrailer(interaction.Trailer))
+
+	if interaction.Code != codes.OK {
+		return status.Error(interaction.Code, interaction.Message)
+	}
+
+	if message, ok := reply.(proto.Message); ok && len(interaction.Response) > 0 {
+		return protojson.Unmarshal(interaction.Response, message)
+	}
+
+	return nil
+}
+
+// marshal converts a request or a response to compact JSON.
+func marshal(message interface{}) (json.RawMessage, error) {
+	m, ok := message.(proto.Message)
+	if !ok {
+		return nil, fmt.Errorf("%T is not a protobuf message", message)
+	}
+
+	encoded, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(m)
+	if err != nil {
+		return nil, err
+	}
+
+	return compact(encoded)
+}
+
+// compact removes the whitespaces that protojson adds at random.
+func compact(encoded []byte) ([]byte, error) {
+	var v interface{}
+	if err := json.Unmarshal(encoded, &v); err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(v)
+}
+
+// ignoredFields differ in every run of the same requests.
+var ignoredFields = map[string]bool{
+	"nonce":             true,
+	"signature":         true,
+	"auditor_signature": true,
+}
+
+// matchKey identifies the requests to be answered by the same interaction.
+func matchKey(method string, request json.RawMessage) (string, error) {
+	var v interface{}
+	if err := json.Unmarshal(request, &v); err != nil {
+		return "", fmt.Errorf("the request of %s is not JSON: %w", method, err)
+	}
+
+	normalized, err := json.Marshal(normalizeRequest(v))
+	if err != nil {
+		return "", err
+	}
+
+	return method + " " + string(normalized), nil
+}
+
+// normalizeRequest removes the ignored fields from the request, including the ones in the contract argument.
+func normalizeRequest(v interface{}) interface{} {
+	switch value := v.(type) {
+	case map[string]interface{}:
+		var normalized = make(map[string]interface{})
+
+		for k, field := range value {
+			if ignoredFields[k] {
+				continue
+			}
+
+			if argument, ok := field.(string); ok && k == "contract_argument" {
+				var parsed interface{}
+				if json.Unmarshal([]byte(argument), &parsed) == nil {
+					field = parsed
+				}
+			}
+
+			normalized[k] = normalizeRequest(field)
+		}
+
+		return normalized
+	case []interface{}:
+		var normalized = make([]interface{}, len(value))
+
+		for i, element := range value {
+			normalized[i] = normalizeRequest(element)
+		}
+
+		return normalized
+	default:
+		return value
+	}
+}
+
+// encodeTrailer encodes the values of the binary trailers in base64.
+func encodeTrailer(trailer metadata.MD) map[string][]string {
+	if trailer.Len() == 0 {
+		return nil
+	}
+
+	var encoded = make(map[string][]string)
+
+	for k, values := range trailer {
+		for _, v := range values {
+			if strings.HasSuffix(k, "-bin") {
+				v = base64.StdEncoding.EncodeToString([]byte(v))
+			}
+
+			encoded[k] = append(encoded[k], v)
+		}
+	}
+
+	return encoded
+}
+
+// decodeTrailer decodes the trailer encoded by encodeTrailer.
+func decodeTrailer(encoded map[string][]string) metadata.MD {
+	var trailer = metadata.MD{}
+
+	for k, values := range encoded {
+		for _, v := range values {
+			if strings.HasSuffix(k, "-bin") {
+				if decoded, err := base64.StdEncoding.DecodeString(v); err == nil {
+					v = string(decoded)
+				}
+			}
+
+			trailer.Append(k, v)
+		}
+	}
+
+	return trailer
+}
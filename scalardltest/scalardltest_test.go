@@ -0,0 +1,330 @@
+package scalardltest
+
+import (
+	"fmt"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/scalar-labs/scalardl-go-client-sdk/v3/client/config"
+	clientError "github.com/scalar-labs/scalardl-go-client-sdk/v3/client/error"
+	"github.com/scalar-labs/scalardl-go-client-sdk/v3/client/service"
+	"github.com/scalar-labs/scalardl-go-client-sdk/v3/crypto"
+	"github.com/scalar-labs/scalardl-go-client-sdk/v3/json"
+	"github.com/scalar-labs/scalardl-go-client-sdk/v3/ledger/statuscode"
+	"google.golang.org/grpc/codes"
+)
+
+func statusCode(err error) statuscode.StatusCode {
+	if e, ok := err.(clientError.ClientError); ok {
+		return e.StatusCode()
+	}
+
+	return 0
+}
+
+func TestServer(t *testing.T) {
+	server, s := NewTestClient(t, Options{})
+
+	for i := 1; i <= 2; i++ {
+		result, err := s.ExecuteContract("counter", json.Object{"asset_id": "a", "amount": 10}, nil)
+		if err != nil {
+			t.Fatalf("should execute the contract: %v", err)
+		}
+
+		if result.Result["balance"] != float64(10*i) {
+			t.Errorf("should return the result of the contract: %v", result.Result)
+		}
+
+		if len(result.Proofs) != 1 || result.Proofs[0].Age != int32(i-1) {
+			t.Errorf("should return the proof of the next age: %v", result.Proofs)
+		}
+
+		verifier, _ := crypto.NewVerifier([]byte(server.LedgerCert()))
+		if !result.Proofs[0].VerifyWith(verifier) {
+			t.Errorf("the proof should be signed by Ledger")
+		}
+	}
+
+	if validated, err := s.ValidateAsset("a"); err != nil || validated.Code != statuscode.OK || validated.Proof.Age != 1 {
+		t.Errorf("should validate the asset: %v %v", validated, err)
+	}
+
+	var argument = json.Object{"asset_id": "a", "amount": 1, "nonce": "used"}
+	if _, err := s.ExecuteContract("counter", argument, nil); err != nil {
+		t.Errorf("should execute the contract: %v", err)
+	}
+
+	if _, err := s.ExecuteContract("counter", argument, nil); statusCode(err) != statuscode.InvalidNonce {
+		t.Errorf("should reject the used nonce: %v", err)
+	}
+
+	if _, err := s.ExecuteContract("counter", json.Object{"asset_id": "a", "amount": -1}, nil); statusCode(err) != statuscode.ContractContextualError {
+		t.Errorf("should fail for the error of the contract: %v", err)
+	}
+
+	if _, err := s.ExecuteContract("missing", json.Object{}, nil); statusCode(err) != statuscode.ContractNotFound {
+		t.Errorf("should fail for a missing contract: %v", err)
+	}
+
+	if err := s.RegisterContract("unknown", "com.example.Unknown", []byte{0xCA, 0xFE}, nil); statusCode(err) != statuscode.UnloadableContract {
+		t.Errorf("should reject an undefined contract: %v", err)
+	}
+
+	if err := s.EnsureContract("counter", CounterName, []byte{0xCA, 0xFE}, nil); err != nil {
+		t.Errorf("should list the registered contract: %v", err)
+	}
+
+	if err := s.RegisterCertificate(); statusCode(err) != statuscode.CertificateAlreadyRegistered {
+		t.Errorf("should reject the registered certificate: %v", err)
+	}
+
+	if err := server.TamperAsset("a", 1, json.Object{"balance": 1000}); err != nil {
+		t.Fatal(err)
+	}
+
+	if validated, err := s.ValidateAsset("a"); err != nil || validated.Code != statuscode.InvalidHash {
+		t.Errorf("should detect the tampered asset: %v %v", validated, err)
+	}
+}
+
+func TestServer_InjectError(t *testing.T) {
+	server, s := NewTestClient(t, Options{})
+
+	server.InjectError("/rpc.Ledger/ExecuteContract", statuscode.DatabaseError, "injected")
+
+	_, err := s.ExecuteContract("counter", json.Object{"asset_id": "a", "amount": 1}, nil)
+	if statusCode(err) != statuscode.DatabaseError || err.Error() != "injected" {
+		t.Errorf("should return the injected error: %v", err)
+	}
+
+	if _, err = s.ExecuteContract("counter", json.Object{"asset_id": "a", "amount": 1}, nil); err != nil {
+		t.Errorf("should return the injected error only once: %v", err)
+	}
+
+	server.InjectError("/rpc.Ledger/ExecuteContract", statuscode.DatabaseError, "injected")
+	server.ClearErrors()
+
+	if _, err = s.ExecuteContract("counter", json.Object{"asset_id": "a", "amount": 1}, nil); err != nil {
+		t.Errorf("should clear the injected errors: %v", err)
+	}
+}
+
+func TestServer_Auditor(t *testing.T) {
+	server, s := NewTestClient(t, Options{Auditor: true})
+
+	result, err := s.ExecuteContract("counter", json.Object{"asset_id": "a", "amount": 10}, nil)
+	if err != nil {
+		t.Fatalf("should execute the contract with Auditor: %v", err)
+	}
+
+	verifier, _ := crypto.NewVerifier([]byte(server.AuditorCert()))
+	if len(result.AuditorProofs) != 1 || !result.AuditorProofs[0].VerifyWith(verifier) {
+		t.Errorf("should return the proofs signed by Auditor: %v", result.AuditorProofs)
+	}
+
+	var wg sync.WaitGroup
+
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			if _, err := s.ExecuteContract("counter", json.Object{"asset_id": "a", "amount": 1}, nil); err != nil {
+				t.Errorf("should execute the contract concurrently: %v", err)
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	if validated, err := s.ValidateAsset("a"); err != nil || validated.Code != statuscode.OK || validated.AuditorProof.Age != 10 {
+		t.Errorf("should validate the asset in Ledger and Auditor: %v %v", validated, err)
+	}
+
+	if err = server.TamperAsset("a", 10, json.Object{"balance": 1000}); err != nil {
+		t.Fatal(err)
+	}
+
+	if validated, err := s.ValidateAsset("a"); err != nil || validated.Code != statuscode.InconsistentStates {
+		t.Errorf("should detect the tampered asset: %v %v", validated, err)
+	}
+}
+
+func TestServer_Hmac(t *testing.T) {
+	server, _ := NewTestClient(t, Options{})
+
+	var c = server.ClientConfig(config.NewClientConfigWithDefaultValues())
+	c.AuthenticationMethod = config.AuthenticationMethodHMAC
+	c.CertHolderID = "bob"
+	c.SecretKey = "secret"
+	c.SecretVersion = 1
+
+	s, err := server.Connect(c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Close()
+
+	if err = RegisterCounter(s); err != nil {
+		t.Fatalf("should register the secret key and the contract: %v", err)
+	}
+
+	if _, err = s.ExecuteContract("counter", json.Object{"asset_id": "b", "amount": 1}, nil); err != nil {
+		t.Errorf("should execute the contract with HMAC: %v", err)
+	}
+}
+
+func TestRecorder(t *testing.T) {
+	server, err := NewServer(Options{Auditor: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	c, err := server.NewClientConfig("alice")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var run = func(s service.ClientService, tamper func()) (codes []statuscode.StatusCode) {
+		codes = append(codes, statusCode(s.RegisterCertificate()))
+		codes = append(codes, statusCode(s.RegisterContract("counter", CounterName, []byte{0xCA, 0xFE}, nil)))
+
+		for _, amount := range []int{1, 1, -1} {
+			_, err := s.ExecuteContract("counter", json.Object{"asset_id": "a", "amount": amount}, nil)
+			codes = append(codes, statusCode(err))
+		}
+
+		tamper()
+
+		validated, _ := s.ValidateAsset("a")
+
+		return append(codes, validated.Code)
+	}
+
+	var recorder = NewRecorder()
+
+	recorded, err := server.Connect(c, recorder.DialOptions()...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	server.InjectError("/rpc.Ledger/ExecuteContract", statuscode.DatabaseError, "injected")
+
+	var expected = run(recorded, func() { server.TamperAsset("a", 0, json.Object{"balance": 1000}) })
+
+	recorded.Close()
+	server.Close()
+
+	if fmt.Sprint(expected) != fmt.Sprint([]statuscode.StatusCode{0, 0, statuscode.DatabaseError, 0, statuscode.ContractContextualError, statuscode.InconsistentStates}) {
+		t.Fatalf("should record the calls: %v", expected)
+	}
+
+	var golden = filepath.Join(t.TempDir(), "golden.json")
+	if err = recorder.Save(golden); err != nil {
+		t.Fatal(err)
+	}
+
+	replayer, err := LoadReplayer(golden)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	replayed, err := service.NewClientServiceWithOptions(c, service.ClientServiceOptions{DialOptions: replayer.DialOptions()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer replayed.Close()
+
+	if actual := run(replayed, func() {}); fmt.Sprint(actual) != fmt.Sprint(expected) {
+		t.Errorf("should replay the recorded calls with new nonces: %v rather than %v", actual, expected)
+	}
+
+	if replayer.Unused() != 0 {
+		t.Errorf("should replay all the interactions: %d", replayer.Unused())
+	}
+
+	if _, err = replayed.ListContracts(""); err == nil {
+		t.Errorf("should fail for the calls not recorded")
+	}
+}
+
+func TestFaultInjector(t *testing.T) {
+	server, err := NewServer(Options{Auditor: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer server.Close()
+
+	var faults = NewFaultInjector()
+
+	s, err := server.NewClientService("alice", faults.DialOptions()...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Close()
+
+	faults.Add(Fault{Method: "/rpc.Ledger/ListContracts", StatusCode: statuscode.DatabaseError, Message: "injected", Times: 1})
+
+	if _, err = s.ListContracts(""); statusCode(err) != statuscode.DatabaseError || err.Error() != "injected" {
+		t.Errorf("should return the status code in the trailer: %v", err)
+	}
+
+	if _, err = s.ListContracts(""); statusCode(err) == statuscode.DatabaseError {
+		t.Errorf("should inject the fault only once: %v", err)
+	}
+
+	if err = RegisterCounter(s); err != nil {
+		t.Fatal(err)
+	}
+
+	faults.Add(Fault{Method: "/rpc.Auditor/ValidateExecution", Corrupt: CorruptHashes, Times: 1})
+
+	if _, err = s.ExecuteContract("counter", json.Object{"asset_id": "a", "amount": 1}, nil); statusCode(err) != statuscode.InconsistentStates {
+		t.Errorf("should detect the mismatched proofs: %v", err)
+	}
+
+	faults.Add(Fault{
+		Method:      "/rpc.Auditor/ValidateExecution",
+		Delay:       100 * time.Millisecond,
+		DropRequest: true,
+		Code:        codes.DeadlineExceeded,
+		Times:       1,
+	})
+
+	var start = time.Now()
+
+	_, err = s.ExecuteContract("counter", json.Object{"asset_id": "a", "amount": 1}, nil)
+	if err == nil || time.Since(start) < 100*time.Millisecond {
+		t.Errorf("should time out after the delay: %v", err)
+	}
+
+	if faults.Applied("/rpc.Auditor/ValidateExecution") != 2 {
+		t.Errorf("should count the calls with the faults: %d", faults.Applied("/rpc.Auditor/ValidateExecution"))
+	}
+
+	faults.Clear()
+
+	// the asset a is left ahead in Ledger since Auditor didn't validate the last execution.
+	if _, err = s.ExecuteContract("counter", json.Object{"asset_id": "b", "amount": 1}, nil); err != nil {
+		t.Errorf("should execute the contract without the faults: %v", err)
+	}
+}
+
+func TestFaultInjector_DropResponse(t *testing.T) {
+	var faults = NewFaultInjector()
+
+	faults.Add(Fault{Method: "/rpc.Ledger/ExecuteContract", DropResponse: true, Times: 1})
+
+	_, s := NewTestClient(t, Options{}, faults.DialOptions()...)
+
+	if _, err := s.ExecuteContract("counter", json.Object{"asset_id": "a", "amount": 1}, nil); err == nil {
+		t.Errorf("should lose the response")
+	}
+
+	if validated, err := s.ValidateAsset("a"); err != nil || validated.Code != statuscode.OK || validated.Proof.Age != 0 {
+		t.Errorf("should commit the execution whose response is lost: %v %v", validated, err)
+	}
+}
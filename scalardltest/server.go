@@ -0,0 +1,259 @@
+// Package scalardltest provides in-process Ledger and Auditor servers to test the code using ClientService
+// without a Scalar DL network. Contracts are defined in Go, and the servers keep the states in memory
+// with the hash chains and the signed proofs as Scalar DL does.
+package scalardltest
+
+import (
+	"context"
+	"crypto/x509/pkix"
+	"fmt"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/scalar-labs/scalardl-go-client-sdk/v3/client/config"
+	clientError "github.com/scalar-labs/scalardl-go-client-sdk/v3/client/error"
+	"github.com/scalar-labs/scalardl-go-client-sdk/v3/crypto"
+	"github.com/scalar-labs/scalardl-go-client-sdk/v3/crypto/keygen"
+	"github.com/scalar-labs/scalardl-go-client-sdk/v3/json"
+	"github.com/scalar-labs/scalardl-go-client-sdk/v3/rpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+const (
+	// LedgerHost is the host name of Ledger in the client config of Server.
+	LedgerHost string = "ledger.scalardltest"
+
+	// AuditorHost is the host name of Auditor in the client config of Server.
+	AuditorHost string = "auditor.scalardltest"
+
+	bufferSize int = 1024 * 1024
+)
+
+// Options defines the optional parameters of NewServer.
+type Options struct {
+	// Auditor starts Auditor along with Ledger. The requests to Ledger are then required to be ordered by Auditor.
+	Auditor bool
+
+	// Contracts defines the contracts by their binary names, as DefineContract does.
+	// Counter is always defined as CounterName.
+	Contracts map[string]Contract
+
+	// ServerOptions are added to the options of the gRPC servers, e.g. interceptors.
+	ServerOptions []grpc.ServerOption
+}
+
+// Server runs Ledger, and optionally Auditor, in the process over in-memory connections.
+// ClientService connects to them with the client config from ClientConfig and the dial options from DialOptions.
+type Server struct {
+	ledger          *node
+	auditor         *node
+	ledgerCert      string
+	auditorCert     string
+	auditorVerifier crypto.Verifier
+
+	listeners map[string]*bufconn.Listener
+	servers   []*grpc.Server
+
+	mutex     sync.Mutex
+	contracts map[string]Contract
+	injected  map[string][]clientError.ClientError
+}
+
+// NewServer starts Ledger, and Auditor if it is enabled, with new keys to sign the proofs.
+// Close stops them.
+func NewServer(options Options) (s *Server, err error) {
+	s = &Server{
+		listeners: make(map[string]*bufconn.Listener),
+		contracts: make(map[string]Contract),
+		injected:  make(map[string][]clientError.ClientError),
+	}
+
+	s.contracts[CounterName] = Counter
+
+	for name, contract := range options.Contracts {
+		s.contracts[name] = contract
+	}
+
+	var signer crypto.Signer
+
+	if s.ledgerCert, signer, err = newSigner("ledger"); err != nil {
+		return nil, err
+	}
+
+	s.ledger = newNode(s, signer)
+
+	if options.Auditor {
+		if s.auditorCert, signer, err = newSigner("auditor"); err != nil {
+			return nil, err
+		}
+
+		if s.auditorVerifier, err = crypto.NewVerifier([]byte(s.auditorCert)); err != nil {
+			return nil, err
+		}
+
+		s.auditor = newNode(s, signer)
+	}
+
+	var c = s.ClientConfig(config.NewClientConfigWithDefaultValues())
+
+	s.serve(options, fmt.Sprintf("%s:%d", LedgerHost, c.LedgerPort), func(g *grpc.Server) {
+		rpc.RegisterLedgerServer(g, ledgerServer{server: s, node: s.ledger})
+	})
+
+	s.serve(options, fmt.Sprintf("%s:%d", LedgerHost, c.LedgerPrivilegedPort), func(g *grpc.Server) {
+		rpc.RegisterLedgerPrivilegedServer(g, ledgerPrivilegedServer{node: s.ledger})
+	})
+
+	if s.auditor != nil {
+		s.serve(options, fmt.Sprintf("%s:%d", AuditorHost, c.AuditorPort), func(g *grpc.Server) {
+			rpc.RegisterAuditorServer(g, auditorServer{server: s, node: s.auditor})
+		})
+
+		s.serve(options, fmt.Sprintf("%s:%d", AuditorHost, c.AuditorPrivilegedPort), func(g *grpc.Server) {
+			rpc.RegisterAuditorPrivilegedServer(g, auditorPrivilegedServer{node: s.auditor})
+		})
+	}
+
+	return
+}
+
+// serve starts a gRPC server listening to the address in memory.
+func (s *Server) serve(options Options, address string, register func(*grpc.Server)) {
+	var (
+		listener = bufconn.Listen(bufferSize)
+		opts     = append([]grpc.ServerOption{grpc.ChainUnaryInterceptor(s.intercept)}, options.ServerOptions...)
+		g        = grpc.NewServer(opts...)
+	)
+
+	register(g)
+
+	s.listeners[address] = listener
+	s.servers = append(s.servers, g)
+
+	go g.Serve(listener)
+}
+
+// Close stops the servers. The connections from ClientService are closed.
+func (s *Server) Close() {
+	for _, g := range s.servers {
+		g.Stop()
+	}
+}
+
+// DialOptions returns the options for ClientService to connect to the servers in memory:
+//
+//	service.NewClientServiceWithOptions(c, service.ClientServiceOptions{DialOptions: server.DialOptions()})
+func (s *Server) DialOptions() []grpc.DialOption {
+	return []grpc.DialOption{
+		grpc.WithContextDialer(func(ctx context.Context, address string) (net.Conn, error) {
+			listener, ok := s.listeners[address]
+			if !ok {
+				return nil, fmt.Errorf("no test server listens to %s", address)
+			}
+
+			return listener.DialContext(ctx)
+		}),
+	}
+}
+
+// ClientConfig returns a copy of the config with the hosts and the ports of the servers.
+// TLS is disabled, and Auditor is enabled if the server runs it.
+func (s *Server) ClientConfig(c config.ClientConfig) config.ClientConfig {
+	var defaults = config.NewClientConfigWithDefaultValues()
+
+	c.LedgerHost = LedgerHost
+	c.LedgerPort = defaults.LedgerPort
+	c.LedgerPrivilegedPort = defaults.LedgerPrivilegedPort
+	c.IsTLSEnabled = false
+	c.IsAuditorEnabled = s.auditor != nil
+	c.AuditorHost = AuditorHost
+	c.AuditorPort = defaults.AuditorPort
+	c.AuditorPrivilegedPort = defaults.AuditorPrivilegedPort
+	c.IsAuditorTLSEnabled = false
+
+	return c
+}
+
+// NewClientConfig creates the client config of a new certificate holder with a generated key
+// and a self-signed certificate as the version 1.
+func (s *Server) NewClientConfig(certHolderID string) (c config.ClientConfig, err error) {
+	c = s.ClientConfig(config.NewClientConfigWithDefaultValues())
+	c.CertHolderID = certHolderID
+	c.CertVersion = 1
+
+	c.PrivateKey, c.Cert, err = newKey(certHolderID)
+
+	return
+}
+
+// DefineContract defines the behavior of the contract with the binary name.
+// The contract registered with the binary name runs it.
+func (s *Server) DefineContract(binaryName string, contract Contract) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.contracts[binaryName] = contract
+}
+
+// contract returns the contract defined with the binary name.
+func (s *Server) contract(binaryName string) (contract Contract, ok bool) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	contract, ok = s.contracts[binaryName]
+
+	return
+}
+
+// LedgerCert returns the certificate of the key that Ledger signs the proofs with.
+func (s *Server) LedgerCert() string {
+	return s.ledgerCert
+}
+
+// AuditorCert returns the certificate of the key that Auditor signs the proofs with,
+// or an empty string without Auditor.
+func (s *Server) AuditorCert() string {
+	return s.auditorCert
+}
+
+// TamperAsset overwrites the data of the age of the asset in Ledger without updating the hash chain,
+// so that the ledger validation detects it.
+func (s *Server) TamperAsset(assetID string, age int, data json.Object) error {
+	s.ledger.mutex.Lock()
+	defer s.ledger.mutex.Unlock()
+
+	var records = s.ledger.assets[assetID]
+	if age < 0 || age >= len(records) {
+		return fmt.Errorf("asset %s (age %d) is not found", assetID, age)
+	}
+
+	records[age].data = clone(data)
+
+	return nil
+}
+
+// newKey generates a key and its self-signed certificate.
+func newKey(commonName string) (key string, cert string, err error) {
+	if key, err = keygen.GenerateKey(); err != nil {
+		return
+	}
+
+	cert, err = keygen.SelfSign(key, pkix.Name{CommonName: commonName}, 24*time.Hour)
+
+	return
+}
+
+// newSigner generates a key and its self-signed certificate for a server to sign the proofs.
+func newSigner(commonName string) (cert string, signer crypto.Signer, err error) {
+	var key string
+
+	if key, cert, err = newKey(commonName); err != nil {
+		return
+	}
+
+	signer, err = crypto.NewSigner([]byte(key), nil)
+
+	return
+}
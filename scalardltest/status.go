@@ -0,0 +1,115 @@
+package scalardltest
+
+import (
+	"context"
+
+	clientError "github.com/scalar-labs/scalardl-go-client-sdk/v3/client/error"
+	"github.com/scalar-labs/scalardl-go-client-sdk/v3/ledger/statuscode"
+	"github.com/scalar-labs/scalardl-go-client-sdk/v3/rpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// statusTrailerKey is the trailer key of the Scalar DL status, which ClientService reads the status code from.
+const statusTrailerKey = "rpc.status-bin"
+
+// InjectError makes the next call of the method fail with the status code and the message,
+// as if the server returned them. The method is the full gRPC method name, e.g. "/rpc.Ledger/ExecuteContract".
+// Errors injected to the same method are returned in order, one for each call.
+func (s *Server) InjectError(method string, code statuscode.StatusCode, message string) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.injected[method] = append(s.injected[method], clientError.NewClientError(code, message))
+}
+
+// ClearErrors removes the injected errors that are not returned yet.
+func (s *Server) ClearErrors() {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.injected = make(map[string][]clientError.ClientError)
+}
+
+// nextInjected pops the next error injected to the method.
+func (s *Server) nextInjected(method string) (err clientError.ClientError, ok bool) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	var errs = s.injected[method]
+	if len(errs) == 0 {
+		return
+	}
+
+	s.injected[method] = errs[1:]
+
+	return errs[0], true
+}
+
+// intercept returns the injected errors, and converts ClientError from the handlers
+// to a gRPC error with the Scalar DL status in the trailer.
+func (s *Server) intercept(
+	ctx context.Context,
+	request interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (response interface{}, err error) {
+	if injected, ok := s.nextInjected(info.FullMethod); ok {
+		err = injected
+	} else {
+		response, err = handler(ctx, request)
+	}
+
+	if e, ok := err.(clientError.ClientError); ok {
+		return nil, toStatusError(ctx, e)
+	}
+
+	return
+}
+
+// toStatusError sets the status code and the message to the trailer, and returns the gRPC error for them.
+func toStatusError(ctx context.Context, e clientError.ClientError) error {
+	grpc.SetTrailer(ctx, statusTrailer(e))
+
+	return status.Error(toCode(e.StatusCode()), e.Error())
+}
+
+// statusTrailer is the trailer with the status code and the message of the error.
+func statusTrailer(e clientError.ClientError) metadata.MD {
+	var trailer, _ = proto.Marshal(&rpc.Status{Code: uint32(e.StatusCode()), Message: e.Error()})
+
+	return metadata.Pairs(statusTrailerKey, string(trailer))
+}
+
+// setTrailer fills the trailer requested by the call options on the client side.
+func setTrailer(opts []grpc.CallOption, trailer metadata.MD) {
+	for _, opt := range opts {
+		if t, ok := opt.(grpc.TrailerCallOption); ok {
+			*t.TrailerAddr = trailer
+		}
+	}
+}
+
+// toCode maps a Scalar DL status code to a gRPC code.
+func toCode(code statuscode.StatusCode) codes.Code {
+	switch code {
+	case statuscode.CertificateNotFound, statuscode.ContractNotFound, statuscode.AssetNotFound, statuscode.FunctionNotFound:
+		return codes.NotFound
+	case statuscode.CertificateAlreadyRegistered, statuscode.ContractAlreadyRegistered, statuscode.SecretAlreadyRegistered:
+		return codes.AlreadyExists
+	case statuscode.InvalidSignature:
+		return codes.Unauthenticated
+	}
+
+	switch {
+	case code < 400:
+		return codes.FailedPrecondition
+	case code < 500:
+		return codes.InvalidArgument
+	default:
+		return codes.Internal
+	}
+}